@@ -5,12 +5,17 @@ import (
 )
 
 type Alias struct {
-	id           int `json:"alias_id"`
-	source       string
-	destinations []struct {
-		atype string `json:"type"`
-		value *json.RawMessage
-	}
+	ID           int                `json:"alias_id"`
+	Source       string             `json:"source"`
+	Destinations []AliasDestination `json:"destinations"`
+}
+
+// AliasDestination is one of the places mail sent to an Alias is delivered.
+type AliasDestination struct {
+	// Type is the kind of destination ('text', 'member', 'officer' or 'list').
+	Type string `json:"type"`
+	// Value is the destination itself, whose structure depends on Type.
+	Value *json.RawMessage `json:"value"`
 }
 
 func (s *Session) GetAllAliases() ([]Alias, error) {
@@ -1,63 +1,116 @@
 package myradio
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/textproto"
 	"net/url"
+	"sync"
+
+	"github.com/UniversityRadioYork/myradio-go/transport"
 )
 
 type Session struct {
-	apikey  string
-	baseurl url.URL
+	client *transport.Client
+	logger *log.Logger
+	// warned records which deprecated methods have been warned about to
+	// logger; it is shared with clones that keep the same logger.
+	warned *sync.Map
 }
 
-func NewSession(apikey string) (*Session, error) {
+// NewSession creates a Session using the given API key, and any given Options.
+func NewSession(apikey string, opts ...Option) (*Session, error) {
 	url, err := url.Parse(`https://ury.york.ac.uk/api/v2`)
 	if err != nil {
 		return nil, err
 	}
-	return &Session{
-		apikey:  apikey,
-		baseurl: *url,
-	}, nil
+	s := &Session{client: transport.NewClient(apikey, *url), warned: new(sync.Map)}
+	s.client.UserAgent = DefaultUserAgent
+	if err = s.apply(opts); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// apiRequest performs a GET request on the given endpoint, with the given mixins.
+func (s *Session) apiRequest(endpoint string, mixins []string) (*json.RawMessage, error) {
+	return s.apiRequestWithParams("GET", endpoint, mixins, nil)
 }
 
-type apiResponse struct {
-	Status  string
-	Payload *json.RawMessage
+// apiRequestInto performs a GET request on the given endpoint, with the given
+// mixins, and decodes the payload into v.
+//
+// This saves copying the payload, so should be used for endpoints that are
+// polled frequently.
+func (s *Session) apiRequestInto(endpoint string, mixins []string, v interface{}) error {
+	_, err := s.do(transport.Call{Method: "GET", Endpoint: endpoint, Mixins: mixins, Into: v})
+	return err
 }
 
-func (s *Session) apiRequest(endpoint string, mixins []string) (*json.RawMessage, error) {
-	theurl := s.baseurl
-	params := url.Values{
-		"api_key": []string{s.apikey},
-		"mixins":  mixins,
+// apiRequestWithParams performs a request on the given endpoint with the given
+// HTTP method, mixins and extra parameters.
+//
+// For GET and DELETE requests, the parameters are sent in the query string;
+// otherwise, they are sent as a form-encoded body.
+func (s *Session) apiRequestWithParams(method, endpoint string, mixins []string, params url.Values) (*json.RawMessage, error) {
+	return s.do(transport.Call{Method: method, Endpoint: endpoint, Mixins: mixins, Params: params})
+}
+
+// apiUpload performs a POST request on the given endpoint, uploading the contents
+// of r (of the given content type) as the file field with the given name.
+//
+// The parameters are sent as extra fields in the same multipart form.
+func (s *Session) apiUpload(endpoint, field string, r io.Reader, contentType string, params url.Values) (*json.RawMessage, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for k, vs := range params {
+		for _, v := range vs {
+			if err := w.WriteField(k, v); err != nil {
+				return nil, err
+			}
+		}
 	}
-	theurl.Path += endpoint
-	theurl.RawQuery = params.Encode()
-	req, err := http.NewRequest("GET", theurl.String(), nil)
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, field, field))
+	h.Set("Content-Type", contentType)
+	part, err := w.CreatePart(h)
 	if err != nil {
 		return nil, err
 	}
-	client := &http.Client{}
-	res, err := client.Do(req)
-	if res.StatusCode != 200 {
-		return nil, fmt.Errorf(endpoint + fmt.Sprintf(" Not ok: HTTP %d", res.StatusCode))
+	if _, err = io.Copy(part, r); err != nil {
+		return nil, err
 	}
-	defer res.Body.Close()
-	data, err := ioutil.ReadAll(res.Body)
-	if err != nil {
+	if err = w.Close(); err != nil {
 		return nil, err
 	}
-	var resJson apiResponse
-	err = json.Unmarshal(data, &resJson)
+	return s.do(transport.Call{
+		Method:      "POST",
+		Endpoint:    endpoint,
+		Body:        buf.Bytes(),
+		ContentType: w.FormDataContentType(),
+	})
+}
+
+// apiRequestJSON performs a request on the given endpoint with the given HTTP
+// method, sending v encoded as JSON as the request body.
+func (s *Session) apiRequestJSON(method, endpoint string, v interface{}) (*json.RawMessage, error) {
+	body, err := json.Marshal(v)
 	if err != nil {
 		return nil, err
 	}
-	if resJson.Status != "OK" {
-		return nil, fmt.Errorf(endpoint + fmt.Sprintf(" Response not OK: %v", resJson))
-	}
-	return resJson.Payload, nil
+	return s.do(transport.Call{
+		Method:      method,
+		Endpoint:    endpoint,
+		Body:        body,
+		ContentType: "application/json",
+	})
+}
+
+// do performs an API call.
+func (s *Session) do(c transport.Call) (*json.RawMessage, error) {
+	return s.client.Do(c)
 }
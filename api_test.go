@@ -0,0 +1,95 @@
+package myradio
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newTestSession creates a Session that sends all requests to the given handler.
+func newTestSession(t *testing.T, h http.Handler) *Session {
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	s, err := NewSession("TEST-KEY", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// writePayload writes an OK API response with the given payload.
+func writePayload(w http.ResponseWriter, payload interface{}) {
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"status":  "OK",
+		"payload": payload,
+	})
+}
+
+// requestRecorder records a summary of each request a test server handles.
+//
+// It is safe for use by concurrent handlers.
+type requestRecorder struct {
+	t    *testing.T
+	mu   sync.Mutex
+	reqs []string
+}
+
+func newRequestRecorder(t *testing.T) *requestRecorder {
+	return &requestRecorder{t: t}
+}
+
+// record records r as its method, path and the form values with the given
+// keys, or its whole encoded POST form if no keys are given, and returns the
+// number of requests recorded so far.
+func (rec *requestRecorder) record(r *http.Request, keys ...string) int {
+	if err := r.ParseForm(); err != nil {
+		rec.t.Error(err)
+	}
+	summary := []string{r.Method, r.URL.Path}
+	if len(keys) == 0 {
+		summary = append(summary, r.PostForm.Encode())
+	}
+	for _, k := range keys {
+		summary = append(summary, r.FormValue(k))
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.reqs = append(rec.reqs, strings.Join(summary, " "))
+	return len(rec.reqs)
+}
+
+// requests returns the summaries of the requests recorded so far, in order.
+func (rec *requestRecorder) requests() []string {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return append([]string(nil), rec.reqs...)
+}
+
+// BenchmarkPollCurrentAndNext measures a complete poll of the now playing endpoint.
+func BenchmarkPollCurrentAndNext(b *testing.B) {
+	data, err := ioutil.ReadFile("testdata/currentandnext.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(data)
+	}))
+	defer srv.Close()
+	s, err := NewSession("TEST-KEY", WithBaseURL(srv.URL))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := s.GetCurrentAndNext(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package myradio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/tracks"
+)
+
+// ArtworkStatus is how far MyRadio has got with resizing an album's artwork.
+type ArtworkStatus = tracks.ArtworkStatus
+
+const (
+	// ArtworkNone is an album with no artwork.
+	ArtworkNone = tracks.ArtworkNone
+	// ArtworkProcessing is artwork that MyRadio is still resizing.
+	ArtworkProcessing = tracks.ArtworkProcessing
+	// ArtworkReady is artwork that has been resized, and is in use.
+	ArtworkReady = tracks.ArtworkReady
+	// ArtworkFailed is artwork that MyRadio could not process, for example
+	// because it isn't a valid image.
+	ArtworkFailed = tracks.ArtworkFailed
+)
+
+// SetAlbumArtwork uploads new artwork for the album with the given ID.
+//
+// The image is read from r, and contentType should be its MIME type (for
+// example, "image/jpeg").
+// MyRadio resizes the image after the upload; use WaitForAlbumArtwork to
+// find out when it is ready.
+//
+// This consumes one API request.
+func (s *Session) SetAlbumArtwork(recordid RecordID, r io.Reader, contentType string) error {
+	_, err := s.apiUpload(fmt.Sprintf("/album/%d/artwork", recordid), "image", r, contentType, nil)
+	return err
+}
+
+// GetAlbumArtworkStatus gets the status of the artwork of the album with the given ID.
+//
+// An album MyRadio gives no status for is taken to have no artwork.
+//
+// This consumes one API request.
+func (s *Session) GetAlbumArtworkStatus(recordid RecordID) (status ArtworkStatus, err error) {
+	data, err := s.apiRequest(fmt.Sprintf("/album/%d/artwork/status", recordid), []string{})
+	if err != nil {
+		return
+	}
+	if data == nil {
+		return ArtworkNone, nil
+	}
+	err = json.Unmarshal(*data, &status)
+	return
+}
+
+// WaitForAlbumArtwork polls the artwork status of the album with the given
+// ID every interval until MyRadio has finished resizing it, then returns the
+// resulting status.
+//
+// Returns ErrPollTimeout if resizing hasn't finished within timeout.
+//
+// This consumes one API request per poll.
+func (s *Session) WaitForAlbumArtwork(recordid RecordID, interval, timeout time.Duration) (status ArtworkStatus, err error) {
+	err = poll(interval, timeout, func() (bool, error) {
+		var perr error
+		status, perr = s.GetAlbumArtworkStatus(recordid)
+		return perr == nil && status != ArtworkProcessing, perr
+	})
+	return
+}
@@ -0,0 +1,55 @@
+package myradio
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSetAlbumArtwork(t *testing.T) {
+	polls := 0
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /album/6789/artwork":
+			f, h, err := r.FormFile("image")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			data, _ := ioutil.ReadAll(f)
+			if string(data) != "JPEGDATA" || h.Header.Get("Content-Type") != "image/jpeg" {
+				t.Error("Got image:", string(data), h.Header)
+			}
+			writePayload(w, nil)
+		case "GET /album/6789/artwork/status":
+			polls++
+			if polls < 3 {
+				writePayload(w, ArtworkProcessing)
+			} else {
+				writePayload(w, ArtworkReady)
+			}
+		default:
+			t.Error("Got request:", r.Method, r.URL.Path)
+		}
+	}))
+
+	if err := s.SetAlbumArtwork(6789, strings.NewReader("JPEGDATA"), "image/jpeg"); err != nil {
+		t.Fatal(err)
+	}
+	status, err := s.WaitForAlbumArtwork(6789, time.Millisecond, time.Second)
+	if err != nil || status != ArtworkReady || polls != 3 {
+		t.Error("Got:", status, "after", polls, "polls, Error:", err)
+	}
+}
+
+func TestGetAlbumArtworkStatusNullPayload(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, nil)
+	}))
+	status, err := s.GetAlbumArtworkStatus(6789)
+	if err != nil || status != ArtworkNone {
+		t.Error("Got:", status, ", Error:", err, ", Expected:", ArtworkNone)
+	}
+}
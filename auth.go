@@ -0,0 +1,26 @@
+package myradio
+
+import "github.com/UniversityRadioYork/myradio-go/transport"
+
+// TokenSource supplies the bearer tokens a Session authenticates with.
+//
+// Token is called before every request, so implementations should cache
+// tokens and only refresh them when they are about to expire.
+//
+// A golang.org/x/oauth2 TokenSource, which does so, can be adapted with
+// TokenSourceFunc:
+//
+//	ts := myradio.TokenSourceFunc(func() (string, error) {
+//		tok, err := oauthSource.Token()
+//		if err != nil {
+//			return "", err
+//		}
+//		return tok.AccessToken, nil
+//	})
+type TokenSource = transport.TokenSource
+
+// TokenSourceFunc is a TokenSource that calls the function it wraps.
+type TokenSourceFunc = transport.TokenSourceFunc
+
+// StaticToken is a TokenSource that always returns the same token.
+type StaticToken = transport.StaticToken
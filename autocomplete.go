@@ -0,0 +1,79 @@
+package myradio
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// findOverfetch is how many times as many results as asked for are requested
+// from the findbyname endpoints, which list every matching track, so that
+// there are still enough once duplicate names are removed.
+const findOverfetch = 4
+
+// findByName queries one of the library's findbyname endpoints, for enough
+// results to find limit distinct names.
+func (s *Session) findByName(endpoint, prefix string, limit int, result interface{}) error {
+	params := url.Values{
+		"title": []string{prefix},
+		"limit": []string{strconv.Itoa(limit * findOverfetch)},
+	}
+	data, err := s.apiRequestWithParams("GET", endpoint, nil, params)
+	if err != nil || data == nil {
+		return err
+	}
+	return json.Unmarshal(*data, result)
+}
+
+// uniqueStrings returns the first limit distinct strings in ss, in order of
+// first appearance.
+func uniqueStrings(ss []string, limit int) []string {
+	var unique []string
+	seen := make(map[string]bool, len(ss))
+	for _, s := range ss {
+		if len(unique) == limit {
+			break
+		}
+		if !seen[s] {
+			seen[s] = true
+			unique = append(unique, s)
+		}
+	}
+	return unique
+}
+
+// FindArtists gets up to limit artist names in the library starting with prefix,
+// for autocompletion.
+//
+// This consumes one API request.
+func (s *Session) FindArtists(prefix string, limit int) ([]string, error) {
+	var artists []struct {
+		Artist string `json:"artist"`
+	}
+	if err := s.findByName("/artist/findbyname", prefix, limit, &artists); err != nil {
+		return nil, err
+	}
+	names := make([]string, len(artists))
+	for k, v := range artists {
+		names[k] = v.Artist
+	}
+	return uniqueStrings(names, limit), nil
+}
+
+// FindAlbumTitles gets up to limit album titles in the library starting with
+// prefix, for autocompletion.
+//
+// This consumes one API request.
+func (s *Session) FindAlbumTitles(prefix string, limit int) ([]string, error) {
+	var albums []struct {
+		Title string `json:"title"`
+	}
+	if err := s.findByName("/album/findbyname", prefix, limit, &albums); err != nil {
+		return nil, err
+	}
+	titles := make([]string, len(albums))
+	for k, v := range albums {
+		titles[k] = v.Title
+	}
+	return uniqueStrings(titles, limit), nil
+}
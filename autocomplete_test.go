@@ -0,0 +1,37 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFindAlbumTitles(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/album/findbyname" || r.FormValue("title") != "Def" {
+			t.Error("Got request:", r.URL.Path, r.URL.RawQuery)
+		}
+		if r.FormValue("limit") != "12" {
+			t.Error("Got limit:", r.FormValue("limit"), ", Expected: enough to allow for duplicates")
+		}
+		// Every track on an album is listed, so titles repeat.
+		w.Write([]byte(`{"status":"OK","payload":[
+			{"title":"Definitely Maybe","recordid":1},
+			{"title":"Definitely Maybe","recordid":1},
+			{"title":"Definitely Maybe","recordid":1},
+			{"title":"Def Leppard","recordid":2},
+			{"title":"Definitely Maybe","recordid":3},
+			{"title":"Defiance","recordid":4},
+			{"title":"Deftones","recordid":5}
+		]}`))
+	}))
+
+	got, err := s.FindAlbumTitles("Def", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"Definitely Maybe", "Def Leppard", "Defiance"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+}
@@ -0,0 +1,301 @@
+package myradio
+
+import (
+	"html"
+	"net/url"
+	"strings"
+)
+
+// bioAllowedTags are the tags kept when sanitising a bio, all without attributes
+// except for a's href.
+var bioAllowedTags = map[string]bool{
+	"a": true, "b": true, "blockquote": true, "br": true, "em": true, "i": true,
+	"li": true, "ol": true, "p": true, "strong": true, "u": true, "ul": true,
+}
+
+// bioDroppedTags are the tags whose content is dropped along with them when
+// sanitising a bio or converting it to plain text.
+var bioDroppedTags = map[string]bool{
+	"iframe": true, "noscript": true, "object": true, "script": true, "style": true, "template": true,
+}
+
+// bioBlockTags are the tags that separate paragraphs in a plain text bio.
+var bioBlockTags = map[string]bool{
+	"blockquote": true, "div": true, "h1": true, "h2": true, "h3": true, "h4": true,
+	"h5": true, "h6": true, "ol": true, "p": true, "table": true, "tr": true, "ul": true,
+}
+
+// htmlToken is a piece of an HTML document: either text, or a start or end tag.
+type htmlToken struct {
+	// text is the (still escaped) text, if this isn't a tag.
+	text string
+	// tag is the lower-case name of the tag, if this is one.
+	tag   string
+	end   bool
+	attrs map[string]string
+}
+
+// tokeniseHTML splits an HTML fragment into tokens, skipping comments and
+// anything in bioDroppedTags.
+//
+// This is only intended for the markup found in bios, not arbitrary documents;
+// anything it can't make sense of is treated as text, and so escaped.
+func tokeniseHTML(s string) []htmlToken {
+	var tokens []htmlToken
+	dropping := ""
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			i = len(s)
+		}
+		if i > 0 {
+			if dropping == "" {
+				tokens = append(tokens, htmlToken{text: s[:i]})
+			}
+			s = s[i:]
+			continue
+		}
+
+		if strings.HasPrefix(s, "<!--") {
+			end := strings.Index(s, "-->")
+			if end < 0 {
+				return tokens
+			}
+			s = s[end+3:]
+			continue
+		}
+		tok, n := parseTag(s)
+		if n == 0 {
+			// A lone '<', which is just text.
+			if dropping == "" {
+				tokens = append(tokens, htmlToken{text: "<"})
+			}
+			s = s[1:]
+			continue
+		}
+		s = s[n:]
+		switch {
+		case dropping != "":
+			if tok.end && tok.tag == dropping {
+				dropping = ""
+			}
+		case bioDroppedTags[tok.tag]:
+			if !tok.end {
+				dropping = tok.tag
+			}
+		case tok.tag != "":
+			tokens = append(tokens, tok)
+		}
+	}
+	return tokens
+}
+
+// parseTag parses the tag at the start of s, returning it and its length in
+// bytes, or a length of 0 if s doesn't start with a tag.
+//
+// Declarations such as <!DOCTYPE> are returned as tags with no name.
+func parseTag(s string) (tok htmlToken, n int) {
+	i := 1
+	if i < len(s) && s[i] == '/' {
+		tok.end = true
+		i++
+	}
+	if i >= len(s) || !(isASCIILetter(s[i]) || (!tok.end && (s[i] == '!' || s[i] == '?'))) {
+		return htmlToken{}, 0
+	}
+	start := i
+	for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '>' && s[i] != '/' {
+		i++
+	}
+	if isASCIILetter(s[start]) {
+		tok.tag = strings.ToLower(s[start:i])
+	}
+	tok.attrs = make(map[string]string)
+	for {
+		for i < len(s) && (isHTMLSpace(s[i]) || s[i] == '/') {
+			i++
+		}
+		if i >= len(s) {
+			return htmlToken{}, 0
+		}
+		if s[i] == '>' {
+			return tok, i + 1
+		}
+		start = i
+		for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '>' && s[i] != '=' && s[i] != '/' {
+			i++
+		}
+		name := strings.ToLower(s[start:i])
+		value := ""
+		if i < len(s) && s[i] == '=' {
+			i++
+			if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+				end := strings.IndexByte(s[i+1:], s[i])
+				if end < 0 {
+					return htmlToken{}, 0
+				}
+				value = s[i+1 : i+1+end]
+				i += end + 2
+			} else {
+				start = i
+				for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '>' {
+					i++
+				}
+				value = s[start:i]
+			}
+		}
+		if _, ok := tok.attrs[name]; !ok {
+			tok.attrs[name] = html.UnescapeString(value)
+		}
+	}
+}
+
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// safeLink returns true if href is a link that is safe to put in a bio:
+// an absolute http, https or mailto URL, or a path on the same site.
+func safeLink(href string) bool {
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto":
+		return true
+	case "":
+		return u.Host == "" && strings.HasPrefix(u.Path, "/") && !strings.HasPrefix(href, "//")
+	}
+	return false
+}
+
+// SanitiseBio returns a version of the given bio that is safe to put in a
+// web page.
+//
+// Only simple formatting tags (paragraphs, emphasis, lists and links to web
+// pages or email addresses, marked nofollow) are kept, without any other attributes;
+// scripts, styles and embedded objects are removed along with their contents,
+// and all other tags are removed but their contents kept.
+// Any unclosed tags are closed at the end.
+//
+// This consumes no API requests.
+func SanitiseBio(bio string) string {
+	var (
+		b    strings.Builder
+		open []string
+	)
+	for _, tok := range tokeniseHTML(bio) {
+		switch {
+		case tok.tag == "":
+			b.WriteString(html.EscapeString(html.UnescapeString(tok.text)))
+		case !bioAllowedTags[tok.tag]:
+			continue
+		case tok.end:
+			// Close everything opened since the matching start tag, if there is one.
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == tok.tag {
+					for _, t := range reverse(open[i:]) {
+						b.WriteString("</" + t + ">")
+					}
+					open = open[:i]
+					break
+				}
+			}
+		case tok.tag == "br":
+			b.WriteString("<br>")
+		case tok.tag == "a":
+			if href, ok := tok.attrs["href"]; ok && safeLink(href) {
+				b.WriteString(`<a href="` + html.EscapeString(strings.TrimSpace(href)) + `" rel="nofollow">`)
+			} else {
+				b.WriteString("<a>")
+			}
+			open = append(open, tok.tag)
+		default:
+			b.WriteString("<" + tok.tag + ">")
+			open = append(open, tok.tag)
+		}
+	}
+	for _, t := range reverse(open) {
+		b.WriteString("</" + t + ">")
+	}
+	return b.String()
+}
+
+// reverse returns a reversed copy of tags.
+func reverse(tags []string) []string {
+	r := make([]string, len(tags))
+	for i, t := range tags {
+		r[len(tags)-1-i] = t
+	}
+	return r
+}
+
+// BioPlainText returns the given bio with all markup removed.
+//
+// Paragraphs are separated by blank lines, and line breaks and list items
+// start new lines; otherwise, whitespace is collapsed as a browser would.
+//
+// This consumes no API requests.
+func BioPlainText(bio string) string {
+	var b strings.Builder
+	for _, tok := range tokeniseHTML(bio) {
+		switch {
+		case tok.tag == "":
+			// Line breaks in the text are just spaces, as in a browser.
+			b.WriteString(strings.Map(func(r rune) rune {
+				if r < 0x80 && isHTMLSpace(byte(r)) {
+					return ' '
+				}
+				return r
+			}, html.UnescapeString(tok.text)))
+		case bioBlockTags[tok.tag]:
+			b.WriteString("\n\n")
+		case tok.tag == "br" || (tok.tag == "li" && !tok.end):
+			b.WriteString("\n")
+		}
+	}
+
+	// Tidy up the spacing around the breaks.
+	var paras []string
+	for _, para := range strings.Split(b.String(), "\n\n") {
+		var lines []string
+		for _, line := range strings.Split(para, "\n") {
+			if line = strings.Join(strings.Fields(line), " "); line != "" {
+				lines = append(lines, line)
+			}
+		}
+		if len(lines) > 0 {
+			paras = append(paras, strings.Join(lines, "\n"))
+		}
+	}
+	return strings.Join(paras, "\n\n")
+}
+
+// GetUserBioHTML gets the bio of the user with the given ID, sanitised with
+// SanitiseBio so that it is safe to put in a web page.
+//
+// This consumes one API request.
+func (s *Session) GetUserBioHTML(id UserID) (string, error) {
+	bio, err := s.GetUserBio(id)
+	if err != nil {
+		return "", err
+	}
+	return SanitiseBio(bio), nil
+}
+
+// GetUserBioPlainText gets the bio of the user with the given ID, with all
+// markup removed by BioPlainText.
+//
+// This consumes one API request.
+func (s *Session) GetUserBioPlainText(id UserID) (string, error) {
+	bio, err := s.GetUserBio(id)
+	if err != nil {
+		return "", err
+	}
+	return BioPlainText(bio), nil
+}
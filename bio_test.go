@@ -0,0 +1,87 @@
+package myradio
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSanitiseBio(t *testing.T) {
+	tests := []struct {
+		bio      string
+		expected string
+	}{
+		{"<p>Presenter of <b>Breakfast</b>.</p>", "<p>Presenter of <b>Breakfast</b>.</p>"},
+		{`<P CLASS="intro" onclick="steal()">Hi</P>`, "<p>Hi</p>"},
+		{"Hi<script>alert('hi')</script> there", "Hi there"},
+		{"<style>p { color: red }</style><div>Boxed</div>", "Boxed"},
+		{`<a href="https://ury.org.uk/">URY</a>`, `<a href="https://ury.org.uk/" rel="nofollow">URY</a>`},
+		{`<a href="/schedule/shows/101">Show</a>`, `<a href="/schedule/shows/101" rel="nofollow">Show</a>`},
+		{`<a href="javascript:alert(1)">Click</a>`, "<a>Click</a>"},
+		{`<a href="//evil.example/">Click</a>`, "<a>Click</a>"},
+		{`<a href='mailto:head.of.computing@ury.org.uk' title="Email">Email</a>`, `<a href="mailto:head.of.computing@ury.org.uk" rel="nofollow">Email</a>`},
+		{"<img src=x onerror=alert(1)>Pic", "Pic"},
+		{"<p><b>Unclosed <i>tags", "<p><b>Unclosed <i>tags</i></b></p>"},
+		{"<b><i>Misnested</b></i>", "<b><i>Misnested</i></b>"},
+		{"Stray </p> close", "Stray  close"},
+		{"1 < 2 & 3 > 2", "1 &lt; 2 &amp; 3 &gt; 2"},
+		{"Fish &amp; chips", "Fish &amp; chips"},
+		{"Line<br/>break<!-- secret -->", "Line<br>break"},
+		{`<a href="https://ury.org.uk/?a=1&amp;b=2">Q</a>`, `<a href="https://ury.org.uk/?a=1&amp;b=2" rel="nofollow">Q</a>`},
+	}
+
+	for _, test := range tests {
+		if got := SanitiseBio(test.bio); got != test.expected {
+			t.Errorf("Bio: %q, Got: %q, Expected: %q", test.bio, got, test.expected)
+		}
+	}
+}
+
+func TestBioPlainText(t *testing.T) {
+	tests := []struct {
+		bio      string
+		expected string
+	}{
+		{"<p>Presenter of <b>Breakfast</b>.</p>", "Presenter of Breakfast."},
+		{"<p>One</p>\n<p>Two\nlines</p>", "One\n\nTwo lines"},
+		{"Likes:<ul><li>Tea</li><li>Radio</li></ul>Fin", "Likes:\n\nTea\nRadio\n\nFin"},
+		{"Line<br>break", "Line\nbreak"},
+		{"Fish &amp; chips<script>x()</script>", "Fish & chips"},
+		{"  Lots   of \t space  ", "Lots of space"},
+	}
+
+	for _, test := range tests {
+		if got := BioPlainText(test.bio); got != test.expected {
+			t.Errorf("Bio: %q, Got: %q, Expected: %q", test.bio, got, test.expected)
+		}
+	}
+}
+
+func TestGetUserBioFormats(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user/7449/bio/":
+			writePayload(w, `<p onclick="steal()">Presenter of <b>Breakfast</b>.<script>alert(1)</script></p><p>Loves <a href="javascript:alert(1)">jazz</a>.</p>`)
+		case "/user/1234/bio/":
+			writePayload(w, nil)
+		default:
+			t.Error("Got request for:", r.URL.Path)
+		}
+	}))
+
+	html, err := s.GetUserBioHTML(7449)
+	if expected := `<p>Presenter of <b>Breakfast</b>.</p><p>Loves <a>jazz</a>.</p>`; err != nil || html != expected {
+		t.Error("Got:", html, ", Error:", err, ", Expected:", expected)
+	}
+	text, err := s.GetUserBioPlainText(7449)
+	if expected := "Presenter of Breakfast.\n\nLoves jazz."; err != nil || text != expected {
+		t.Errorf("Got: %q, Error: %v, Expected: %q", text, err, expected)
+	}
+
+	// Members without a bio give the same error as GetUserBio.
+	if _, err = s.GetUserBioHTML(1234); err == nil {
+		t.Error("Expected an error for a missing bio")
+	}
+	if _, err = s.GetUserBioPlainText(1234); err == nil {
+		t.Error("Expected an error for a missing bio")
+	}
+}
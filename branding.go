@@ -0,0 +1,87 @@
+package myradio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/schedule"
+)
+
+// ShowImageType is the kind of a piece of show artwork.
+type ShowImageType = schedule.ShowImageType
+
+const (
+	// ShowImagePhoto is a show's cover photo, shown alongside it in the schedule.
+	ShowImagePhoto = schedule.ShowImagePhoto
+	// ShowImageBanner is a wide banner, shown at the top of a show's page.
+	ShowImageBanner = schedule.ShowImageBanner
+)
+
+// ShowImage is a piece of artwork uploaded for a show.
+type ShowImage = schedule.ShowImage
+
+// ImageURL turns the site-relative path of an image, such as ShowMeta.Photo,
+// into an absolute URL.
+//
+// If size is nonzero, MyRadio scales the image so its largest side is at
+// most size pixels.
+// Returns an empty string if the path is empty.
+//
+// This consumes no API requests.
+func (s *Session) ImageURL(path string, size int) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	ref, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	// Image paths are relative to the site root, not the API.
+	root := s.client.BaseURL
+	root.Path = "/"
+	u := root.ResolveReference(ref)
+	if size > 0 {
+		q := u.Query()
+		q.Set("size", strconv.Itoa(size))
+		u.RawQuery = q.Encode()
+	}
+	return u.String(), nil
+}
+
+// GetShowImages gets all artwork uploaded for the show with the given ID.
+//
+// This consumes one API request.
+func (s *Session) GetShowImages(id ShowID) (images []ShowImage, err error) {
+	data, err := s.apiRequest(fmt.Sprintf("/show/%d/images", id), []string{})
+	if err != nil || data == nil {
+		return
+	}
+	err = json.Unmarshal(*data, &images)
+	if err != nil {
+		return
+	}
+	for k, v := range images {
+		images[k].DateAdded, err = time.Parse(dateTimeLayout, v.DateAddedRaw)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// UploadShowImage uploads new artwork of the given type for the show with the given ID.
+//
+// The image is read from r, and contentType should be its MIME type (for
+// example, "image/png").
+// The new image replaces the show's current image of that type.
+//
+// This consumes one API request.
+func (s *Session) UploadShowImage(id ShowID, imageType ShowImageType, r io.Reader, contentType string) error {
+	params := url.Values{"type": []string{string(imageType)}}
+	_, err := s.apiUpload(fmt.Sprintf("/show/%d/images", id), "image", r, contentType, params)
+	return err
+}
@@ -0,0 +1,81 @@
+package myradio
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetPhotoURL(t *testing.T) {
+	s, err := NewSession("TEST-KEY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		photo    string
+		size     int
+		expected string
+	}{
+		{"", 0, ""},
+		{"/media/image_meta/ShowImageMetadata/101.png", 0, "https://ury.york.ac.uk/media/image_meta/ShowImageMetadata/101.png"},
+		{"/media/image_meta/ShowImageMetadata/101.png", 256, "https://ury.york.ac.uk/media/image_meta/ShowImageMetadata/101.png?size=256"},
+		{"https://cdn.example.com/101.png", 0, "https://cdn.example.com/101.png"},
+	}
+
+	for _, test := range tests {
+		show := ShowMeta{Photo: test.photo}
+		got, err := show.GetPhotoURL(s, test.size)
+		if err != nil || got != test.expected {
+			t.Error("Got:", got, ", Expected:", test.expected, ", Error:", err)
+		}
+	}
+}
+
+func TestImageURLs(t *testing.T) {
+	// Images are served from the root of whichever site the API is on.
+	s, err := NewSession("TEST-KEY", WithBaseURL("https://mirror.example.com/api/v2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	show := Show{Photo: "/media/image_meta/ShowImageMetadata/101.png"}
+	got, err := show.GetPhotoURL(s, 0)
+	if expected := "https://mirror.example.com/media/image_meta/ShowImageMetadata/101.png"; err != nil || got != expected {
+		t.Error("Got:", got, ", Expected:", expected, ", Error:", err)
+	}
+	image := ShowImage{Url: "/media/image_meta/ShowImageMetadata/56.jpg?v=2"}
+	got, err = image.GetURL(s, 1024)
+	if expected := "https://mirror.example.com/media/image_meta/ShowImageMetadata/56.jpg?size=1024&v=2"; err != nil || got != expected {
+		t.Error("Got:", got, ", Expected:", expected, ", Error:", err)
+	}
+	if got, err = (&Show{}).GetPhotoURL(s, 256); err != nil || got != "" {
+		t.Error("Got:", got, ", Error:", err, ", Expected: no URL for no photo")
+	}
+}
+
+func TestUploadShowImage(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/show/101/images" {
+			t.Error("Got request:", r.Method, r.URL.Path)
+		}
+		if r.FormValue("type") != "banner" {
+			t.Error("Got type:", r.FormValue("type"))
+		}
+		f, h, err := r.FormFile("image")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		data, _ := ioutil.ReadAll(f)
+		if string(data) != "PNGDATA" || h.Header.Get("Content-Type") != "image/png" {
+			t.Error("Got image:", string(data), h.Header)
+		}
+		writePayload(w, nil)
+	}))
+
+	if err := s.UploadShowImage(101, ShowImageBanner, strings.NewReader("PNGDATA"), "image/png"); err != nil {
+		t.Error(err)
+	}
+}
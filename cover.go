@@ -0,0 +1,119 @@
+package myradio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// CoverRequestStatus is the state of a CoverRequest.
+type CoverRequestStatus string
+
+const (
+	// CoverRequestOpen is a cover request nobody has accepted yet.
+	CoverRequestOpen CoverRequestStatus = "open"
+	// CoverRequestFilled is a cover request somebody has accepted.
+	CoverRequestFilled CoverRequestStatus = "filled"
+	// CoverRequestCancelled is a cover request that is no longer needed.
+	CoverRequestCancelled CoverRequestStatus = "cancelled"
+)
+
+// CoverRequest is a request for somebody to cover a timeslot whose presenter
+// is absent.
+type CoverRequest struct {
+	CoverRequestID uint               `json:"coverrequestid"`
+	TimeslotID     TimeslotID         `json:"timeslot_id"`
+	RequestedBy    Member             `json:"requested_by"`
+	Note           string             `json:"note"`
+	Status         CoverRequestStatus `json:"status"`
+	// CoveredBy is the member covering the timeslot, if the request is filled.
+	CoveredBy  *Member   `json:"covered_by"`
+	CreatedRaw string    `json:"created"`
+	Created    time.Time `json:"-"`
+}
+
+// parseCoverRequestDates fills in the parsed dates of each CoverRequest.
+func parseCoverRequestDates(requests []CoverRequest) (err error) {
+	for k, v := range requests {
+		requests[k].Created, err = time.Parse(dateTimeLayout, v.CreatedRaw)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// LogAbsence records that the member with the given ID can't present the
+// timeslot with the given ID, for the given reason.
+//
+// This consumes one API request.
+func (s *Session) LogAbsence(timeslotid TimeslotID, memberid UserID, reason string) error {
+	params := url.Values{
+		"memberid": []string{memberid.String()},
+		"reason":   []string{reason},
+	}
+	_, err := s.apiRequestWithParams("POST", fmt.Sprintf("/timeslot/%d/absence", timeslotid), nil, params)
+	return err
+}
+
+// RequestCover asks for somebody to cover the timeslot with the given ID,
+// with a note for potential volunteers.
+//
+// This consumes one API request.
+func (s *Session) RequestCover(timeslotid TimeslotID, note string) (request CoverRequest, err error) {
+	params := url.Values{"note": []string{note}}
+	data, err := s.apiRequestWithParams("POST", fmt.Sprintf("/timeslot/%d/requestcover", timeslotid), nil, params)
+	if err != nil {
+		return
+	}
+	if data == nil {
+		err = errors.New("No cover request created")
+		return
+	}
+	err = json.Unmarshal(*data, &request)
+	if err != nil {
+		return
+	}
+	request.Created, err = time.Parse(dateTimeLayout, request.CreatedRaw)
+	return
+}
+
+// GetOpenCoverRequestsPage gets a page of up to limit cover requests nobody
+// has accepted yet, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetOpenCoverRequestsPage(limit, offset int) (*Page[CoverRequest], error) {
+	page, err := getPage[CoverRequest](s, "/coverrequest/open", []string{}, limit, offset, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = parseCoverRequestDates(page.Items); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// GetOpenCoverRequests gets all cover requests nobody has accepted yet.
+//
+// This consumes one API request.
+func (s *Session) GetOpenCoverRequests() ([]CoverRequest, error) {
+	page, err := s.GetOpenCoverRequestsPage(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// AcceptCoverRequest records that the member with the given ID will cover
+// the timeslot in the cover request with the given ID.
+//
+// This consumes one API request.
+func (s *Session) AcceptCoverRequest(id uint, memberid UserID) error {
+	params := url.Values{"memberid": []string{memberid.String()}}
+	_, err := s.apiRequestWithParams("POST", fmt.Sprintf("/coverrequest/%d/accept", id), nil, params)
+	return err
+}
@@ -0,0 +1,66 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCoverWrites(t *testing.T) {
+	rec := newRequestRecorder(t)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r)
+		if r.URL.Path == "/timeslot/303/requestcover" {
+			writePayload(w, CoverRequest{
+				CoverRequestID: 31,
+				TimeslotID:     303,
+				Note:           r.PostForm.Get("note"),
+				Status:         CoverRequestOpen,
+				CreatedRaw:     "25/10/2015 21:40",
+			})
+			return
+		}
+		writePayload(w, nil)
+	}))
+
+	if err := s.LogAbsence(303, 7449, "Off sick"); err != nil {
+		t.Fatal(err)
+	}
+	request, err := s.RequestCover(303, "Anyone free?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRequest := CoverRequest{
+		CoverRequestID: 31,
+		TimeslotID:     303,
+		Note:           "Anyone free?",
+		Status:         CoverRequestOpen,
+		CreatedRaw:     "25/10/2015 21:40",
+		Created:        time.Date(2015, 10, 25, 21, 40, 0, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(request, expectedRequest) {
+		t.Errorf("Got: %+v, Expected: %+v", request, expectedRequest)
+	}
+	if err = s.AcceptCoverRequest(31, 1234); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /timeslot/303/absence memberid=7449&reason=Off+sick",
+		"POST /timeslot/303/requestcover note=Anyone+free%3F",
+		"POST /coverrequest/31/accept memberid=1234",
+	}
+	if got := rec.requests(); !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+}
+
+func TestRequestCoverNullPayload(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, nil)
+	}))
+	if _, err := s.RequestCover(303, "Anyone free?"); err == nil {
+		t.Error("Expected an error when no cover request was created")
+	}
+}
@@ -0,0 +1,14 @@
+package myradio
+
+import (
+	"io"
+)
+
+// SetDebugWriter makes the Session dump every request and response to w.
+//
+// The API key is redacted from the dumps.
+// Passing nil turns dumping off again.
+// This may be called at any time, including while requests are in progress.
+func (s *Session) SetDebugWriter(w io.Writer) {
+	s.client.SetDebugWriter(w)
+}
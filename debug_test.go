@@ -0,0 +1,64 @@
+package myradio
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDebugWriterRedactsKey(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, "echo "+r.FormValue("api_key"))
+	}))
+
+	var buf bytes.Buffer
+	s.SetDebugWriter(&buf)
+	if _, err := s.GetUserName(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetTrackLoudness(1, -14, -1); err != nil {
+		t.Fatal(err)
+	}
+
+	dump := buf.String()
+	if strings.Contains(dump, "TEST-KEY") {
+		t.Error("API key leaked into debug output:", dump)
+	}
+	for _, expected := range []string{"> GET ", "> PUT ", "< HTTP 200 /user/1/name/", "integrated_lufs=-14", "echo REDACTED"} {
+		if !strings.Contains(dump, expected) {
+			t.Errorf("Debug output missing %q: %s", expected, dump)
+		}
+	}
+
+	buf.Reset()
+	s.SetDebugWriter(nil)
+	if _, err := s.GetUserName(1); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Error("Got debug output after turning it off:", buf.String())
+	}
+}
+
+func TestDebugWriterDumpsJSONBodies(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, []TracklistEntryResult{{AudioLogID: 900}})
+	}))
+
+	var buf bytes.Buffer
+	s.SetDebugWriter(&buf)
+	// An artist that happens to contain the key is still redacted.
+	if _, err := s.SubmitTracklist(42, []TracklistEntry{{Artist: "TEST-KEY", Title: "Wonderwall", TimeRaw: 1500000000}}); err != nil {
+		t.Fatal(err)
+	}
+
+	dump := buf.String()
+	if strings.Contains(dump, "TEST-KEY") {
+		t.Error("API key leaked into debug output:", dump)
+	}
+	expected := `[{"artist":"REDACTED","title":"Wonderwall","time":1500000000}]`
+	if !strings.Contains(dump, expected) {
+		t.Errorf("Debug output missing %q: %s", expected, dump)
+	}
+}
@@ -0,0 +1,640 @@
+package myradio
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newFixtureSession creates a Session serving the given testdata fixture
+// (a complete API response) for requests to the given endpoint path.
+func newFixtureSession(t *testing.T, path, fixture string) *Session {
+	data, err := ioutil.ReadFile("testdata/" + fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Error("Got request for:", r.URL.Path, ", Expected:", path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	}))
+}
+
+var testComputingTeam = Team{
+	TeamID:      4,
+	Name:        "Computing",
+	Alias:       "computing",
+	Ordering:    4,
+	Description: "Keeps the computers on air.",
+	Status:      "c",
+}
+
+func rawMessage(s string) *json.RawMessage {
+	m := json.RawMessage(s)
+	return &m
+}
+
+var testShowMeta = ShowMeta{
+	ShowID:        101,
+	Title:         "URY Breakfast",
+	CreditsString: "Jane Bloggs",
+	Credits:       []Credit{},
+	Description:   "Waking up York.",
+	ShowTypeID:    1,
+	Photo:         "/media/image_meta/ShowImageMetadata/101.png",
+}
+
+var testFullShowMeta = ShowMeta{
+	ShowID:        101,
+	Slug:          "ury-breakfast",
+	Title:         "URY Breakfast",
+	CreditsString: "Jane Bloggs",
+	Credits: []Credit{
+		{
+			Type:     1,
+			MemberID: 7449,
+			User: Member{
+				Memberid: 7449,
+				Fname:    "Jane",
+				Sname:    "Bloggs",
+				Sex:      "f",
+				Email:    "jane.bloggs@ury.org.uk",
+			},
+		},
+	},
+	Description:   "Waking up York.",
+	ShowTypeID:    1,
+	Season:        Link{Display: "text", Value: 3.0, Title: "View Seasons", URL: "/myradio/Scheduler/listSeasons?showid=101"},
+	EditLink:      Link{Display: "icon", Value: "pencil", Title: "Edit Show", URL: "/myradio/Scheduler/editShow?showid=101"},
+	ApplyLink:     Link{Display: "icon", Value: "calendar", Title: "Apply for a new Season", URL: "/myradio/Scheduler/editSeason?showid=101"},
+	MicroSiteLink: Link{Display: "icon", Value: "link", Title: "View Show Microsite", URL: "/schedule/shows/101"},
+	Photo:         "/media/image_meta/ShowImageMetadata/101.png",
+}
+
+var testSeason = func() Season {
+	meta := testShowMeta
+	meta.Season = Link{Display: "text", Value: 3.0, Title: "View Seasons", URL: "/myradio/Scheduler/listSeasons?showid=101"}
+	meta.EditLink = Link{Display: "icon", Value: "pencil", Title: "Edit Season", URL: "/myradio/Scheduler/editSeason?seasonid=202"}
+	meta.ApplyLink = Link{Display: "icon", Value: "calendar", Title: "Apply for a new Season", URL: "/myradio/Scheduler/editSeason?showid=101"}
+	meta.MicroSiteLink = Link{Display: "icon", Value: "link", Title: "View Show Microsite", URL: "/schedule/shows/101"}
+	return Season{
+		ShowMeta:      meta,
+		SeasonID:      202,
+		SeasonNum:     3,
+		SubmittedRaw:  "01/09/2015 12:00",
+		Submitted:     time.Date(2015, 9, 1, 12, 0, 0, 0, time.UTC),
+		RequestedTime: "Mondays 07:00",
+		FirstTimeRaw:  "05/10/2015 07:00",
+		FirstTime:     time.Date(2015, 10, 5, 7, 0, 0, 0, time.UTC),
+		NumEpisodes:   Link{Display: "text", Value: 10.0, URL: "/myradio/Scheduler/listTimeslots?show_season_id=202"},
+		AllocateLink:  Link{Display: "icon", Value: "check", Title: "Allocate Timeslots", URL: "/myradio/Scheduler/allocate?show_season_id=202"},
+		RejectLink:    Link{Display: "icon", Value: "trash", Title: "Reject Application", URL: "/myradio/Scheduler/reject?show_season_id=202"},
+	}
+}()
+
+var testTimeslot = func() Timeslot {
+	season := testSeason
+	season.Season = Link{Display: "text", Value: 3.0, URL: "/myradio/Scheduler/listSeasons?showid=101"}
+	season.EditLink = Link{Display: "icon", Value: "pencil", Title: "Edit Timeslot", URL: "/myradio/Scheduler/editTimeslot?show_season_timeslot_id=303"}
+	season.ApplyLink = Link{Display: "icon", Value: "calendar", URL: "/myradio/Scheduler/editSeason?showid=101"}
+	season.MicroSiteLink = Link{Display: "icon", Value: "link", URL: "/schedule/shows/timeslots/303"}
+	season.AllocateLink.Title = ""
+	season.RejectLink.Title = ""
+	return Timeslot{
+		Season:         season,
+		TimeslotID:     303,
+		TimeslotNum:    7,
+		Tags:           []string{"breakfast", "chat"},
+		Time:           time.Unix(1445842800, 0),
+		TimeRaw:        1445842800,
+		StartTime:      time.Date(2015, 10, 26, 7, 0, 0, 0, time.UTC),
+		StartTimeRaw:   "26/10/2015 07:00",
+		Duration:       2 * time.Hour,
+		DurationRaw:    "02:00:00",
+		MixcloudStatus: "Uploaded",
+	}
+}()
+
+var testTrack = Track{
+	ID:          12345,
+	Title:       "Wonderwall",
+	Artist:      "Oasis",
+	Type:        "central",
+	Length:      "00:04:18",
+	Intro:       15,
+	Outro:       20,
+	IsClean:     true,
+	IsDigitised: true,
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		fixture  string
+		call     func(s *Session) (interface{}, error)
+		expected interface{}
+	}{
+		{
+			"Track", "/track/12345", "track.json",
+			func(s *Session) (interface{}, error) { return s.GetTrack(12345) },
+			&testTrack,
+		},
+		{
+			"Album", "/track/12345/album", "album.json",
+			func(s *Session) (interface{}, error) { return s.GetTrackAlbum(12345) },
+			&Album{
+				ID:            6789,
+				Title:         "(What's the Story) Morning Glory?",
+				Artist:        "Oasis",
+				DateAdded:     "12/03/2004",
+				DateReleased:  NewNullable("02/10/1995"),
+				LastModified:  "05/01/2016",
+				CDID:          NewNullable("B000024LDH"),
+				Location:      NewNullable("Library"),
+				ShelfLetter:   NewNullable("O"),
+				ShelfNumber:   NewNullable("42"),
+				Format:        "a",
+				Medium:        "c",
+				AddingMember:  7449,
+				EditingMember: NewNullable[UserID](1234),
+				RecordLabel:   NewNullable("Creation"),
+				Status:        "d",
+			},
+		},
+		{
+			"Photo", "/user/7449/profilephoto/", "profilephoto.json",
+			func(s *Session) (interface{}, error) { return s.GetUserProfilePhoto(7449) },
+			Photo{
+				PhotoId:      1042,
+				DateAddedRaw: "14/10/2015 19:32",
+				DateAdded:    time.Date(2015, 10, 14, 19, 32, 0, 0, time.UTC),
+				Format:       "png",
+				Owner:        7449,
+				Url:          "/media/image_meta/MyRadioImageMetadata/1042.png",
+			},
+		},
+		{
+			"Officership", "/user/7449/officerships/", "officerships.json",
+			func(s *Session) (interface{}, error) { return s.GetUserOfficerships(7449) },
+			[]Officership{
+				{
+					OfficerId:   3,
+					OfficerName: "Station Manager",
+					TeamId:      1,
+					FromDateRaw: "2014-06-01",
+					FromDate:    time.Date(2014, 6, 1, 0, 0, 0, 0, time.UTC),
+					TillDateRaw: "2015-06-01",
+					TillDate:    time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					OfficerId:   12,
+					OfficerName: "Head of Computing",
+					TeamId:      4,
+					FromDateRaw: "2015-06-01",
+					FromDate:    time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			"Bio", "/user/7449/bio/", "bio.json",
+			func(s *Session) (interface{}, error) { return s.GetUserBio(7449) },
+			"<p>Presenter of <b>Breakfast</b>.</p>",
+		},
+		{
+			"Name", "/user/7449/name/", "name.json",
+			func(s *Session) (interface{}, error) { return s.GetUserName(7449) },
+			"Jane Bloggs",
+		},
+		{
+			"Member", "/user/7449", "member.json",
+			func(s *Session) (interface{}, error) { return s.GetMember(7449) },
+			&Member{
+				Memberid:     7449,
+				Fname:        "Jane",
+				Sname:        "Bloggs",
+				Sex:          "f",
+				Email:        "jane.bloggs@ury.org.uk",
+				Receiveemail: true,
+			},
+		},
+		{
+			"ShowMeta", "/show/101", "showmeta.json",
+			func(s *Session) (interface{}, error) { return s.GetShow(101) },
+			&testFullShowMeta,
+		},
+		{
+			// An unknown slug has a null payload.
+			"ShowBySlugUnknown", "/show/byslug/no-such-show", "null.json",
+			func(s *Session) (interface{}, error) {
+				show, err := s.GetShowBySlug("no-such-show")
+				return show == nil && err != nil, nil
+			},
+			true,
+		},
+		{
+			"ShowBySlug", "/show/byslug/ury-breakfast", "showmeta.json",
+			func(s *Session) (interface{}, error) { return s.GetShowBySlug("ury-breakfast") },
+			&testFullShowMeta,
+		},
+		{
+			"Season", "/show/101/allseasons", "seasons.json",
+			func(s *Session) (interface{}, error) { return s.GetSeasons(101) },
+			[]Season{testSeason},
+		},
+		{
+			"Timeslot", "/timeslot/303", "timeslot.json",
+			func(s *Session) (interface{}, error) { return s.GetTimeslot(303) },
+			testTimeslot,
+		},
+		{
+			"TracklistItem", "/tracklistItem/tracklistfortimeslot/303", "tracklist.json",
+			func(s *Session) (interface{}, error) { return s.GetTracklistForTimeslot(303) },
+			[]TracklistItem{
+				{
+					Track: testTrack,
+					Album: Album{
+						ID:     6789,
+						Title:  "(What's the Story) Morning Glory?",
+						Artist: "Oasis",
+						Status: "d",
+					},
+					EditLink:     Link{Display: "icon", Value: "pencil", URL: "/myradio/Tracklist/editItem?audiologid=9001"},
+					DeleteLink:   Link{Display: "icon", Value: "trash", URL: "/myradio/Tracklist/deleteItem?audiologid=9001"},
+					Time:         time.Unix(1445843100, 0),
+					TimeRaw:      1445843100,
+					StartTime:    time.Date(2015, 10, 26, 7, 5, 0, 0, time.UTC),
+					StartTimeRaw: "26/10/2015 07:05:00",
+					AudioLogID:   9001,
+				},
+			},
+		},
+		{
+			"CurrentAndNext", "/timeslot/currentandnext", "currentandnext.json",
+			func(s *Session) (interface{}, error) { return s.GetCurrentAndNext() },
+			&CurrentAndNext{
+				Current: Show{
+					Title:        "URY Breakfast",
+					Desc:         "Waking up York.",
+					Photo:        "/media/image_meta/ShowImageMetadata/101.png",
+					StartTimeRaw: 1445842800,
+					StartTime:    time.Unix(1445842800, 0),
+					EndTimeRaw:   1445850000,
+					EndTime:      time.Unix(1445850000, 0),
+					Presenters:   "Jane Bloggs",
+					Url:          "/schedule/shows/timeslots/303",
+					Id:           303,
+				},
+				Next: Show{
+					Title:        "Jukebox",
+					Desc:         "Non-stop music.",
+					StartTimeRaw: 1445850000,
+					StartTime:    time.Unix(1445850000, 0),
+					EndTimeRaw:   1445853600,
+					EndTime:      time.Unix(1445853600, 0),
+				},
+			},
+		},
+		{
+			"List", "/list/alllists", "alllists.json",
+			func(s *Session) (interface{}, error) { return s.GetAllLists() },
+			[]List{{Listid: 1, Name: "All Members", Address: "members", Recipients: 312}},
+		},
+		{
+			"Alias", "/alias/allaliases", "allaliases.json",
+			func(s *Session) (interface{}, error) { return s.GetAllAliases() },
+			[]Alias{
+				{
+					ID:     5,
+					Source: "computing",
+					Destinations: []AliasDestination{
+						{Type: "text", Value: rawMessage(`"webmaster"`)},
+						{Type: "officer", Value: rawMessage(`{"officerid": 12}`)},
+					},
+				},
+			},
+		},
+		{
+			"VacantOfficers", "/officer/allofficers/", "allofficers.json",
+			func(s *Session) (interface{}, error) { return s.GetVacantOfficerPositions() },
+			[]Officer{
+				{
+					OfficerID: 12,
+					Name:      "Head of Computing",
+					Alias:     "head.of.computing",
+					Team: Team{
+						TeamID:      4,
+						Name:        "Computing",
+						Alias:       "computing",
+						Ordering:    4,
+						Description: "Keeps the computers on air.",
+						Status:      "c",
+					},
+					Ordering:    2,
+					Description: "Runs the Computing team.",
+					Status:      "c",
+					Type:        "o",
+					Current:     []Member{},
+				},
+			},
+		},
+		{
+			"OfficerHistory", "/officer/3/history/", "officerhistory.json",
+			func(s *Session) (interface{}, error) { return s.GetOfficerHistory(3) },
+			[]OfficerHolder{
+				{
+					MemberOfficerID: 501,
+					User:            Member{Memberid: 1234, Fname: "Joe", Sname: "Bloggs", Sex: "m", Email: "joe.bloggs@ury.org.uk", Receiveemail: true},
+					FromDateRaw:     "2014-06-01",
+					FromDate:        time.Date(2014, 6, 1, 0, 0, 0, 0, time.UTC),
+					TillDateRaw:     "2015-06-01",
+					TillDate:        time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					MemberOfficerID: 620,
+					User:            Member{Memberid: 7449, Fname: "Jane", Sname: "Bloggs", Sex: "f", Email: "jane.bloggs@ury.org.uk"},
+					FromDateRaw:     "2015-06-01",
+					FromDate:        time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			"TrackSegue", "/track/12345/segue", "segue.json",
+			func(s *Session) (interface{}, error) { return s.GetTrackSegue(12345) },
+			&TrackSegue{
+				FadeOut:    251500 * time.Millisecond,
+				FadeOutRaw: 251.5,
+				Overlap:    4250 * time.Millisecond,
+				OverlapRaw: 4.25,
+			},
+		},
+		{
+			"LibraryStats", "/track/librarystats", "librarystats.json",
+			func(s *Session) (interface{}, error) { return s.GetLibraryStats() },
+			&LibraryStats{
+				TotalTracks:     80000,
+				DigitisedTracks: 62000,
+				TotalAlbums:     9500,
+				AlbumsByMedium:  map[string]uint64{"c": 7000, "v": 1500, "d": 1000},
+				RecentAdditions: []Album{
+					{ID: 9999, Title: "Be Here Now", Artist: "Oasis", DateAdded: "10/05/2016", Status: "d"},
+				},
+			},
+		},
+		{
+			"Podcast", "/podcast/77", "podcast.json",
+			func(s *Session) (interface{}, error) { return s.GetPodcast(77) },
+			Podcast{
+				PodcastID:      77,
+				Title:          "Breakfast Highlights",
+				Description:    "The best bits of this week's Breakfast.",
+				ShowID:         101,
+				Status:         PodcastScheduled,
+				SubmittedRaw:   "27/10/2015 10:15",
+				Submitted:      time.Date(2015, 10, 27, 10, 15, 0, 0, time.UTC),
+				PublishTime:    time.Unix(1446206400, 0),
+				PublishTimeRaw: 1446206400,
+				FileURL:        "/media/podcasts/MyRadioPodcast77.mp3",
+			},
+		},
+		{
+			"CurrentOfficers", "/officer/allofficers/", "allofficers.json",
+			func(s *Session) (interface{}, error) { return s.GetCurrentOfficers() },
+			[]Member{{Memberid: 7449, Fname: "Jane", Sname: "Bloggs", Sex: "f", Email: "jane.bloggs@ury.org.uk"}},
+		},
+		{
+			"CurrentPresenters", "/show/allshows", "allshows.json",
+			func(s *Session) (interface{}, error) { return s.GetCurrentPresenters() },
+			[]Member{
+				{Memberid: 7449, Fname: "Jane", Sname: "Bloggs"},
+				{Memberid: 4242, Fname: "Ann", Sname: "Other"},
+			},
+		},
+		{
+			"Photos", "/user/7449/allphotos/", "allphotos.json",
+			func(s *Session) (interface{}, error) { return s.GetUserPhotos(7449) },
+			[]Photo{
+				{
+					PhotoId:      880,
+					DateAddedRaw: "02/09/2014 11:05",
+					DateAdded:    time.Date(2014, 9, 2, 11, 5, 0, 0, time.UTC),
+					Format:       "jpeg",
+					Owner:        7449,
+					Url:          "/media/image_meta/MyRadioImageMetadata/880.jpeg",
+				},
+				{
+					PhotoId:      1042,
+					DateAddedRaw: "14/10/2015 19:32",
+					DateAdded:    time.Date(2015, 10, 14, 19, 32, 0, 0, time.UTC),
+					Format:       "png",
+					Owner:        7449,
+					Url:          "/media/image_meta/MyRadioImageMetadata/1042.png",
+				},
+			},
+		},
+		{
+			"FindArtists", "/artist/findbyname", "findartists.json",
+			func(s *Session) (interface{}, error) { return s.FindArtists("O", 10) },
+			[]string{"Oasis", "Ocean Colour Scene"},
+		},
+		{
+			"CoverRequests", "/coverrequest/open", "opencoverrequests.json",
+			func(s *Session) (interface{}, error) { return s.GetOpenCoverRequests() },
+			[]CoverRequest{
+				{
+					CoverRequestID: 31,
+					TimeslotID:     303,
+					RequestedBy:    Member{Memberid: 7449, Fname: "Jane", Sname: "Bloggs"},
+					Note:           "Off sick, anyone free?",
+					Status:         CoverRequestOpen,
+					CreatedRaw:     "25/10/2015 21:40",
+					Created:        time.Date(2015, 10, 25, 21, 40, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			"SelectorLockHistory", "/selector/lockhistory", "selectorlockhistory.json",
+			func(s *Session) (interface{}, error) { return s.GetSelectorLockHistory(2) },
+			[]SelectorLockEvent{
+				{
+					Reason:  "Maintenance finished",
+					Member:  Member{Memberid: 1234, Fname: "Joe", Sname: "Bloggs"},
+					Time:    time.Unix(1445860800, 0),
+					TimeRaw: 1445860800,
+				},
+				{
+					Locked:  true,
+					Reason:  "Transmitter maintenance",
+					Member:  Member{Memberid: 1234, Fname: "Joe", Sname: "Bloggs"},
+					Time:    time.Unix(1445857200, 0),
+					TimeRaw: 1445857200,
+				},
+			},
+		},
+		{
+			"TeamHistory", "/team/4/history/", "teamhistory.json",
+			func(s *Session) (interface{}, error) { return s.GetTeamHistory(4) },
+			[]OfficerHolder{
+				{
+					MemberOfficerID: 620,
+					User:            Member{Memberid: 7449, Fname: "Jane", Sname: "Bloggs", Sex: "f", Email: "jane.bloggs@ury.org.uk"},
+					FromDateRaw:     "2015-06-01",
+					FromDate:        time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC),
+					Officer: &Officer{
+						OfficerID: 12,
+						Name:      "Head of Computing",
+						Alias:     "head.of.computing",
+						Team: Team{
+							TeamID:      4,
+							Name:        "Computing",
+							Alias:       "computing",
+							Ordering:    4,
+							Description: "Keeps the computers on air.",
+							Status:      "c",
+						},
+						Ordering:    2,
+						Description: "Runs the Computing team.",
+						Status:      "c",
+						Type:        "o",
+					},
+				},
+			},
+		},
+		{
+			"SilenceStatus", "/selector/silence", "silence.json",
+			func(s *Session) (interface{}, error) { return s.GetSilenceStatus() },
+			&SilenceAlarm{
+				AlarmID:        77,
+				Studio:         2,
+				StartTime:      time.Unix(1445857200, 0),
+				StartTimeRaw:   1445857200,
+				EndTime:        time.Unix(1445857260, 0),
+				EndTimeRaw:     1445857260,
+				AcknowledgedBy: &Member{Memberid: 1234, Fname: "Joe", Sname: "Bloggs"},
+				Note:           "Presenter fell asleep",
+			},
+		},
+		{
+			"AlbumWithNulls", "/track/12346/album", "albumnulls.json",
+			func(s *Session) (interface{}, error) { return s.GetTrackAlbum(12346) },
+			&Album{
+				ID:           6790,
+				Title:        "Demo Tape",
+				Artist:       "The Local Band",
+				DateAdded:    "01/02/2016",
+				LastModified: "01/02/2016",
+				Format:       "d",
+				Medium:       "d",
+				AddingMember: 7449,
+				Status:       "d",
+			},
+		},
+		{
+			"SelectorStatus", "/selector/status", "selectorstatus.json",
+			func(s *Session) (interface{}, error) { return s.GetSelectorStatus() },
+			SelectorStatus{
+				Studio:          2,
+				Locked:          true,
+				LockReason:      "Transmitter maintenance",
+				LastModified:    time.Unix(1445857200, 0),
+				LastModifiedRaw: 1445857200,
+			},
+		},
+		{
+			"TeamCurrentMembers", "/team/4/currentholders/", "teamcurrentholders.json",
+			func(s *Session) (interface{}, error) { return s.GetTeamCurrentMembers(4) },
+			[]OfficerHolder{
+				{
+					MemberOfficerID: 620,
+					User:            Member{Memberid: 7449, Fname: "Jane", Sname: "Bloggs", Sex: "f", Email: "jane.bloggs@ury.org.uk"},
+					FromDateRaw:     "2015-06-01",
+					FromDate:        time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC),
+					Officer: &Officer{
+						OfficerID:   12,
+						Name:        "Head of Computing",
+						Alias:       "head.of.computing",
+						Team:        testComputingTeam,
+						Ordering:    2,
+						Description: "Runs the Computing team.",
+						Status:      "c",
+						Type:        "o",
+					},
+				},
+				{
+					MemberOfficerID: 634,
+					User:            Member{Memberid: 1234, Fname: "Joe", Sname: "Bloggs"},
+					FromDateRaw:     "2015-10-01",
+					FromDate:        time.Date(2015, 10, 1, 0, 0, 0, 0, time.UTC),
+					Officer: &Officer{
+						OfficerID:   13,
+						Name:        "Assistant Head of Computing",
+						Alias:       "assistant.head.of.computing",
+						Team:        testComputingTeam,
+						Ordering:    3,
+						Description: "Helps run the Computing team.",
+						Status:      "c",
+						Type:        "a",
+					},
+				},
+			},
+		},
+		{
+			"ShowImages", "/show/101/images", "showimages.json",
+			func(s *Session) (interface{}, error) { return s.GetShowImages(101) },
+			[]ShowImage{
+				{
+					ImageID:      55,
+					Type:         ShowImagePhoto,
+					Url:          "/media/image_meta/ShowImageMetadata/55.png",
+					DateAddedRaw: "03/10/2015 12:00",
+					DateAdded:    time.Date(2015, 10, 3, 12, 0, 0, 0, time.UTC),
+					Current:      true,
+				},
+				{
+					ImageID:      56,
+					Type:         ShowImageBanner,
+					Url:          "/media/image_meta/ShowImageMetadata/56.jpg",
+					DateAddedRaw: "04/10/2015 09:30",
+					DateAdded:    time.Date(2015, 10, 4, 9, 30, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			"StudioTrainedMembers", "/trainingstatus/1/awardedto", "trainingawarded.json",
+			func(s *Session) (interface{}, error) { return s.GetStudioTrainedMembers() },
+			[]Member{
+				{Memberid: 7449, Fname: "Jane", Sname: "Bloggs"},
+				{Memberid: 1234, Fname: "Joe", Sname: "Bloggs"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s := newFixtureSession(t, test.path, test.fixture)
+		got, err := test.call(s)
+		if err != nil {
+			t.Error(test.name, "Error:", err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%s\nGot:      %+v\nExpected: %+v", test.name, got, test.expected)
+		}
+	}
+}
+
+func TestDecodeIntoErrorPayload(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"FAIL","payload":"Not now"}`))
+	}))
+	_, err := s.GetCurrentAndNext()
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.Message != "Not now" {
+		t.Error("Got:", err, ", Expected: an APIError with the payload message")
+	}
+}
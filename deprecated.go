@@ -0,0 +1,41 @@
+package myradio
+
+// This file contains methods kept under their old names while code moves
+// over to the new ones.
+// Each logs a warning, once per logger, if the Session has a logger.
+
+// deprecated warns, if the Session has a logger, that the method old is
+// deprecated in favour of replacement.
+func (s *Session) deprecated(old, replacement string) {
+	if s.logger == nil {
+		return
+	}
+	if _, warned := s.warned.LoadOrStore(old, true); warned {
+		return
+	}
+	s.logger.Printf("myradio: %s is deprecated; use %s instead", old, replacement)
+}
+
+// GetMembers gets the members subscribed to the given mailing list.
+//
+// Deprecated: use GetListMembers.
+func (s *Session) GetMembers(l *List) ([]Member, error) {
+	s.deprecated("GetMembers", "GetListMembers")
+	return s.GetListMembers(l)
+}
+
+// GetSearchMeta gets the shows whose metadata matches the given search term.
+//
+// Deprecated: use SearchShows.
+func (s *Session) GetSearchMeta(term string) ([]ShowMeta, error) {
+	s.deprecated("GetSearchMeta", "SearchShows")
+	return s.SearchShows(term)
+}
+
+// GetTrackListForTimeslot gets the tracks played during the timeslot with the given ID.
+//
+// Deprecated: use GetTracklistForTimeslot.
+func (s *Session) GetTrackListForTimeslot(id TimeslotID) ([]TracklistItem, error) {
+	s.deprecated("GetTrackListForTimeslot", "GetTracklistForTimeslot")
+	return s.GetTracklistForTimeslot(id)
+}
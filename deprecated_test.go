@@ -0,0 +1,59 @@
+package myradio
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDeprecatedWarnsOnce(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, []ShowMeta{})
+	}))
+	var buf bytes.Buffer
+	s, err := s.Clone(WithLogger(log.New(&buf, "", 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err = s.GetSearchMeta("breakfast"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := strings.Count(buf.String(), "GetSearchMeta is deprecated; use SearchShows"); got != 1 {
+		t.Error("Got", got, "warnings, Expected: 1:", buf.String())
+	}
+}
+
+func TestDeprecatedWarnsEachLogger(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, []ShowMeta{})
+	}))
+
+	var bufs [2]bytes.Buffer
+	for i := range bufs {
+		c, err := s.Clone(WithLogger(log.New(&bufs[i], "", 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.GetSearchMeta("breakfast"); err != nil {
+			t.Fatal(err)
+		}
+		// Clones keeping the logger share its record of warnings.
+		same, err := c.Clone()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = same.GetSearchMeta("breakfast"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := range bufs {
+		if got := strings.Count(bufs[i].String(), "GetSearchMeta is deprecated"); got != 1 {
+			t.Error("Logger", i, ", Got", got, "warnings, Expected: 1:", bufs[i].String())
+		}
+	}
+}
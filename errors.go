@@ -0,0 +1,19 @@
+package myradio
+
+import (
+	"github.com/UniversityRadioYork/myradio-go/transport"
+)
+
+// APIError is the error returned when MyRadio responds to a request with an error.
+type APIError = transport.APIError
+
+// IsNotFound returns true if err is an APIError for a missing object.
+func IsNotFound(err error) bool {
+	return transport.IsNotFound(err)
+}
+
+// IsPermissionDenied returns true if err is an APIError for a request the caller
+// isn't permitted to make.
+func IsPermissionDenied(err error) bool {
+	return transport.IsPermissionDenied(err)
+}
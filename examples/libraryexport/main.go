@@ -0,0 +1,80 @@
+// Command libraryexport writes the tracks by an artist in the MyRadio
+// library, or on one of its records, to standard output as CSV.
+//
+// Usage:
+//
+//	libraryexport -key API_KEY (-artist NAME | -record ID) [-page 100]
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/UniversityRadioYork/myradio-go"
+)
+
+func main() {
+	key := flag.String("key", os.Getenv("MYRADIO_API_KEY"), "MyRadio API key (default $MYRADIO_API_KEY)")
+	baseURL := flag.String("url", "https://ury.york.ac.uk/api/v2", "MyRadio API URL")
+	artist := flag.String("artist", "", "export tracks by this artist")
+	record := flag.Uint64("record", 0, "export tracks on the record with this ID")
+	page := flag.Int("page", 100, "how many tracks to fetch per request")
+	flag.Parse()
+	if *key == "" {
+		log.Fatal("libraryexport: an API key is needed (-key or $MYRADIO_API_KEY)")
+	}
+	if (*artist == "") == (*record == 0) {
+		log.Fatal("libraryexport: give exactly one of -artist or -record")
+	}
+	if *page <= 0 {
+		log.Fatal("libraryexport: -page must be positive")
+	}
+
+	session, err := myradio.NewSession(*key, myradio.WithBaseURL(*baseURL), myradio.WithUserAgent("libraryexport-example"))
+	if err != nil {
+		log.Fatal("libraryexport: ", err)
+	}
+
+	w := csv.NewWriter(os.Stdout)
+	if err = w.Write([]string{"trackid", "artist", "title", "length", "intro", "clean", "digitised"}); err != nil {
+		log.Fatal("libraryexport: ", err)
+	}
+	for offset := 0; ; {
+		var tracks *myradio.Page[myradio.Track]
+		if *artist != "" {
+			tracks, err = session.GetTracksByArtistPage(*artist, *page, offset)
+		} else {
+			tracks, err = session.GetTracksByRecordPage(myradio.RecordID(*record), *page, offset)
+		}
+		if err != nil {
+			// Flush what we have, so a partial export isn't lost.
+			w.Flush()
+			log.Fatal("libraryexport: ", err)
+		}
+		for _, t := range tracks.Items {
+			err = w.Write([]string{
+				t.ID.String(),
+				t.Artist,
+				t.Title,
+				t.Length,
+				t.IntroDuration().String(),
+				strconv.FormatBool(t.IsClean),
+				strconv.FormatBool(t.IsDigitised),
+			})
+			if err != nil {
+				log.Fatal("libraryexport: ", err)
+			}
+		}
+		if !tracks.HasMore || len(tracks.Items) == 0 {
+			break
+		}
+		offset = tracks.NextOffset
+	}
+	w.Flush()
+	if err = w.Error(); err != nil {
+		log.Fatal("libraryexport: ", err)
+	}
+}
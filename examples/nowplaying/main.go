@@ -0,0 +1,82 @@
+// Command nowplaying prints the show on air, and the one after it, whenever
+// they change.
+//
+// Usage:
+//
+//	nowplaying -key API_KEY [-interval 30s] [-once]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go"
+)
+
+func main() {
+	key := flag.String("key", os.Getenv("MYRADIO_API_KEY"), "MyRadio API key (default $MYRADIO_API_KEY)")
+	baseURL := flag.String("url", "https://ury.york.ac.uk/api/v2", "MyRadio API URL")
+	interval := flag.Duration("interval", 30*time.Second, "how often to check what's on")
+	once := flag.Bool("once", false, "print what's on once, then exit")
+	flag.Parse()
+	if *key == "" {
+		log.Fatal("nowplaying: an API key is needed (-key or $MYRADIO_API_KEY)")
+	}
+
+	session, err := myradio.NewSession(*key,
+		myradio.WithBaseURL(*baseURL),
+		myradio.WithTimeout(10*time.Second),
+		myradio.WithUserAgent("nowplaying-example"),
+	)
+	if err != nil {
+		log.Fatal("nowplaying: ", err)
+	}
+	session.SetMaintenanceRetries(3, time.Minute)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var last string
+	for {
+		cn, err := session.GetCurrentAndNext()
+		switch {
+		case errors.Is(err, myradio.ErrMaintenance):
+			log.Print("nowplaying: MyRadio is down for maintenance")
+		case err != nil:
+			// Keep going; the next poll may work.
+			log.Print("nowplaying: ", err)
+		default:
+			if now := describe(cn); now != last {
+				fmt.Println(now)
+				last = now
+			}
+		}
+		if *once {
+			if err != nil {
+				os.Exit(1)
+			}
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(*interval):
+		}
+	}
+}
+
+// describe describes the current and next shows in one line.
+func describe(cn *myradio.CurrentAndNext) string {
+	now := fmt.Sprintf("Now: %s (until %s)", cn.Current.Title, cn.Current.EndTime.Format("15:04"))
+	if cn.Next.Title != "" {
+		now += fmt.Sprintf("; next: %s at %s", cn.Next.Title, cn.Next.StartTime.Format("15:04"))
+	}
+	return now
+}
@@ -0,0 +1,90 @@
+// Command schedule prints a week of the MyRadio schedule, optionally
+// reporting what has changed since the last time it was run.
+//
+// Usage:
+//
+//	schedule -key API_KEY [-year 2015 -week 44] [-history schedule.json]
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go"
+)
+
+func main() {
+	key := flag.String("key", os.Getenv("MYRADIO_API_KEY"), "MyRadio API key (default $MYRADIO_API_KEY)")
+	baseURL := flag.String("url", "https://ury.york.ac.uk/api/v2", "MyRadio API URL")
+	thisYear, thisWeek := time.Now().ISOWeek()
+	year := flag.Int("year", thisYear, "year of the week to print")
+	week := flag.Int("week", thisWeek, "ISO week number of the week to print")
+	historyPath := flag.String("history", "", "file to keep schedule snapshots in, to report changes")
+	flag.Parse()
+	if *key == "" {
+		log.Fatal("schedule: an API key is needed (-key or $MYRADIO_API_KEY)")
+	}
+
+	session, err := myradio.NewSession(*key, myradio.WithBaseURL(*baseURL), myradio.WithUserAgent("schedule-example"))
+	if err != nil {
+		log.Fatal("schedule: ", err)
+	}
+
+	timeslots, err := session.GetWeekSchedule(*year, *week)
+	if myradio.IsNotFound(err) {
+		log.Fatalf("schedule: no schedule for week %d of %d", *week, *year)
+	} else if err != nil {
+		log.Fatal("schedule: ", err)
+	}
+	for _, t := range timeslots {
+		fmt.Printf("%s  %-8s %s (%s)\n", t.StartTime.Format("Mon 02 Jan 15:04"), t.Duration, t.Title, t.SeasonEpisodeString())
+	}
+
+	if *historyPath != "" {
+		if err = reportChanges(*historyPath, myradio.NewScheduleSnapshot(time.Now(), timeslots)); err != nil {
+			log.Fatal("schedule: ", err)
+		}
+	}
+}
+
+// reportChanges prints how the schedule has changed since the last snapshot
+// in the history at path, then adds snap to it.
+func reportChanges(path string, snap *myradio.ScheduleSnapshot) error {
+	var history myradio.ScheduleHistory
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		// This is the first run.
+	case err != nil:
+		return err
+	default:
+		if err = json.Unmarshal(data, &history); err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+	}
+
+	if last := history.At(snap.Taken); last != nil {
+		changes := myradio.DiffSchedules(last, snap)
+		fmt.Printf("\n%d changes since %s\n", len(changes), last.Taken.Format(time.RFC1123))
+		for _, c := range changes {
+			entry := c.New
+			if entry == nil {
+				entry = c.Old
+			}
+			fmt.Printf("  %-7s %s %s\n", c.Type, entry.StartTime.Format("Mon 02 Jan 15:04"), entry.Title)
+		}
+	}
+
+	history.Add(snap)
+	data, err = json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,48 @@
+package myradio
+
+import (
+	"fmt"
+)
+
+// exists checks whether the given endpoint refers to an existing object.
+//
+// The payload of the endpoint is never decoded, so callers should pick the
+// lightest endpoint available for the object.
+func (s *Session) exists(endpoint string) (bool, error) {
+	_, err := s.apiRequest(endpoint, nil)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// TrackExists checks whether a track with the given ID exists.
+//
+// This consumes one API request.
+func (s *Session) TrackExists(trackid TrackID) (bool, error) {
+	return s.exists(fmt.Sprintf("/track/%d/title", trackid))
+}
+
+// AlbumExists checks whether an album with the given ID exists.
+//
+// This consumes one API request.
+func (s *Session) AlbumExists(recordid RecordID) (bool, error) {
+	return s.exists(fmt.Sprintf("/album/%d/title", recordid))
+}
+
+// UserExists checks whether a user with the given ID exists.
+//
+// This consumes one API request.
+func (s *Session) UserExists(id UserID) (bool, error) {
+	return s.exists(fmt.Sprintf("/user/%d/name/", id))
+}
+
+// ShowExists checks whether a show with the given ID exists.
+//
+// This consumes one API request.
+func (s *Session) ShowExists(id ShowID) (bool, error) {
+	return s.exists(fmt.Sprintf("/show/%d/title", id))
+}
@@ -0,0 +1,80 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTrackExists(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/track/1/title":
+			writePayload(w, "Exists")
+		case "/track/2/title":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":"FAIL","payload":"Track does not exist"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	tests := []struct {
+		id       TrackID
+		expected bool
+		wantErr  bool
+	}{
+		{1, true, false},
+		{2, false, false},
+		{3, false, true},
+	}
+
+	for _, test := range tests {
+		got, err := s.TrackExists(test.id)
+		if got != test.expected || (err != nil) != test.wantErr {
+			t.Error("ID:", test.id, "Got:", got, ", Expected:", test.expected, ", Error:", err)
+		}
+	}
+}
+
+func TestOtherExists(t *testing.T) {
+	var got []string
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.Path)
+		switch r.URL.Path {
+		case "/album/6789/title", "/user/7449/name/", "/show/101/title":
+			writePayload(w, "Exists")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":"FAIL","payload":"Does not exist"}`))
+		}
+	}))
+
+	tests := []struct {
+		name     string
+		exists   func() (bool, error)
+		expected bool
+	}{
+		{"Album", func() (bool, error) { return s.AlbumExists(6789) }, true},
+		{"MissingAlbum", func() (bool, error) { return s.AlbumExists(6790) }, false},
+		{"User", func() (bool, error) { return s.UserExists(7449) }, true},
+		{"MissingUser", func() (bool, error) { return s.UserExists(7450) }, false},
+		{"Show", func() (bool, error) { return s.ShowExists(101) }, true},
+		{"MissingShow", func() (bool, error) { return s.ShowExists(102) }, false},
+	}
+
+	for _, test := range tests {
+		exists, err := test.exists()
+		if err != nil || exists != test.expected {
+			t.Error(test.name, ", Got:", exists, ", Error:", err, ", Expected:", test.expected)
+		}
+	}
+	expected := []string{
+		"/album/6789/title", "/album/6790/title",
+		"/user/7449/name/", "/user/7450/name/",
+		"/show/101/title", "/show/102/title",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Error("Got requests:", got, ", Expected:", expected)
+	}
+}
@@ -0,0 +1,93 @@
+package myradio
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFailover(t *testing.T) {
+	primaryRequests, mirrorRequests := 0, 0
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryRequests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mirrorRequests++
+		writePayload(w, "Jane Bloggs")
+	}))
+	defer mirror.Close()
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	s, err := NewSession("TEST-KEY", WithBaseURL(primary.URL), WithMirrors(down.URL, mirror.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		name, err := s.GetUserName(1)
+		if err != nil || name != "Jane Bloggs" {
+			t.Error("Got:", name, ", Error:", err)
+		}
+	}
+	// After the first request, the failed servers should be passed over.
+	if primaryRequests != 1 || mirrorRequests != 3 {
+		t.Error("Got:", primaryRequests, "primary and", mirrorRequests, "mirror requests, Expected: 1 and 3")
+	}
+
+	// Writes must not be retried once they may have reached a server.
+	s, err = NewSession("TEST-KEY", WithBaseURL(primary.URL), WithMirrors(mirror.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.SetTrackLoudness(1, -14, -1); err == nil {
+		t.Error("Expected the write to fail rather than fail over")
+	}
+	if mirrorRequests != 3 {
+		t.Error("Got:", mirrorRequests, "mirror requests, Expected: 3")
+	}
+}
+
+func TestFailoverUnavailable(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		// failover is whether the mirror should be tried.
+		failover bool
+	}{
+		{"Rebooting", "<html><body>503 Service Unavailable</body></html>", true},
+		{"Maintenance", `{"status":"FAIL","payload":"MyRadio is read-only for an upgrade"}`, false},
+	}
+
+	for _, test := range tests {
+		primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(test.body))
+		}))
+		mirrorRequests := 0
+		mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mirrorRequests++
+			writePayload(w, "Jane Bloggs")
+		}))
+
+		s, err := NewSession("TEST-KEY", WithBaseURL(primary.URL), WithMirrors(mirror.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		name, err := s.GetUserName(1)
+		if test.failover && (err != nil || name != "Jane Bloggs") {
+			t.Error(test.name, ", Got:", name, ", Error:", err)
+		}
+		if !test.failover && !errors.Is(err, ErrMaintenance) {
+			t.Error(test.name, ", Got:", err, ", Expected: a maintenance error")
+		}
+		if got := mirrorRequests == 1; got != test.failover {
+			t.Error(test.name, ", Got:", mirrorRequests, "mirror requests, Expected failover:", test.failover)
+		}
+		primary.Close()
+		mirror.Close()
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/UniversityRadioYork/myradio-go
+
+go 1.24
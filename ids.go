@@ -0,0 +1,63 @@
+package myradio
+
+import (
+	"github.com/UniversityRadioYork/myradio-go/schedule"
+	"github.com/UniversityRadioYork/myradio-go/tracks"
+	"github.com/UniversityRadioYork/myradio-go/users"
+)
+
+// UserID is the ID of a MyRadio user, that is, of a Member.
+type UserID = users.UserID
+
+// TrackID is the ID of a Track in the URY track database.
+type TrackID = tracks.TrackID
+
+// RecordID is the ID of an Album (a record) in the URY track database.
+type RecordID = tracks.RecordID
+
+// ShowID is the ID of a show.
+type ShowID = schedule.ShowID
+
+// TimeslotID is the ID of a Timeslot, that is, of one episode of a show.
+type TimeslotID = schedule.TimeslotID
+
+// OfficerID is the ID of an Officer position.
+type OfficerID = users.OfficerID
+
+// TeamID is the ID of a Team of officers.
+type TeamID = users.TeamID
+
+// ParseUserID parses a UserID written in decimal, as in a URL.
+func ParseUserID(s string) (UserID, error) {
+	return users.ParseUserID(s)
+}
+
+// ParseTrackID parses a TrackID written in decimal, as in a URL.
+func ParseTrackID(s string) (TrackID, error) {
+	return tracks.ParseTrackID(s)
+}
+
+// ParseRecordID parses a RecordID written in decimal, as in a URL.
+func ParseRecordID(s string) (RecordID, error) {
+	return tracks.ParseRecordID(s)
+}
+
+// ParseShowID parses a ShowID written in decimal, as in a URL.
+func ParseShowID(s string) (ShowID, error) {
+	return schedule.ParseShowID(s)
+}
+
+// ParseTimeslotID parses a TimeslotID written in decimal, as in a URL.
+func ParseTimeslotID(s string) (TimeslotID, error) {
+	return schedule.ParseTimeslotID(s)
+}
+
+// ParseOfficerID parses an OfficerID written in decimal, as in a URL.
+func ParseOfficerID(s string) (OfficerID, error) {
+	return users.ParseOfficerID(s)
+}
+
+// ParseTeamID parses a TeamID written in decimal, as in a URL.
+func ParseTeamID(s string) (TeamID, error) {
+	return users.ParseTeamID(s)
+}
@@ -0,0 +1,36 @@
+package myradio
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseIDs(t *testing.T) {
+	tests := []struct {
+		s       string
+		parse   func(string) (fmt.Stringer, error)
+		wantErr bool
+	}{
+		{"7449", func(s string) (fmt.Stringer, error) { return ParseUserID(s) }, false},
+		{"18446744073709551615", func(s string) (fmt.Stringer, error) { return ParseTrackID(s) }, false},
+		{"12345", func(s string) (fmt.Stringer, error) { return ParseRecordID(s) }, false},
+		{"271", func(s string) (fmt.Stringer, error) { return ParseShowID(s) }, false},
+		{"195806", func(s string) (fmt.Stringer, error) { return ParseTimeslotID(s) }, false},
+		{"12", func(s string) (fmt.Stringer, error) { return ParseOfficerID(s) }, false},
+		{"3", func(s string) (fmt.Stringer, error) { return ParseTeamID(s) }, false},
+		{"-3", func(s string) (fmt.Stringer, error) { return ParseTeamID(s) }, true},
+		{"-1", func(s string) (fmt.Stringer, error) { return ParseTrackID(s) }, true},
+		{"01234x", func(s string) (fmt.Stringer, error) { return ParseUserID(s) }, true},
+	}
+
+	for _, test := range tests {
+		id, err := test.parse(test.s)
+		if (err != nil) != test.wantErr {
+			t.Error("Parsing:", test.s, ", Error:", err, ", Expected error:", test.wantErr)
+			continue
+		}
+		if err == nil && id.String() != test.s {
+			t.Error("Got:", id.String(), ", Expected:", test.s)
+		}
+	}
+}
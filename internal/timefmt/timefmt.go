@@ -0,0 +1,41 @@
+// Package timefmt holds the layouts of the times and durations in MyRadio API
+// responses, shared by the myradio packages that decode and encode them.
+package timefmt
+
+import (
+	"fmt"
+	"time"
+)
+
+// Layouts of the times and durations in API responses.
+const (
+	Date          = "2006-01-02"
+	DateTime      = "02/01/2006 15:04"
+	TracklistTime = "02/01/2006 15:04:05"
+	Duration      = "15:04:05"
+)
+
+// Format formats t in the given layout, or returns raw if t is zero.
+func Format(t time.Time, layout, raw string) string {
+	if t.IsZero() {
+		return raw
+	}
+	return t.Format(layout)
+}
+
+// Unix converts t to a Unix timestamp, or returns raw if t is zero.
+func Unix(t time.Time, raw int64) int64 {
+	if t.IsZero() {
+		return raw
+	}
+	return t.Unix()
+}
+
+// FormatDuration formats a time.Duration in the Duration layout.
+func FormatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	h := d / time.Hour
+	m := (d % time.Hour) / time.Minute
+	s := (d % time.Minute) / time.Second
+	return fmt.Sprintf("%02d:%02d:%02d", h, m, s)
+}
@@ -0,0 +1,25 @@
+package timefmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		durStr   string
+		expected string
+	}{
+		{"2h", "02:00:00"},
+		{"30m", "00:30:00"},
+		{"1h2m3s", "01:02:03"},
+		{"26h", "26:00:00"},
+	}
+
+	for _, test := range tests {
+		dur, _ := time.ParseDuration(test.durStr)
+		if got := FormatDuration(dur); got != test.expected {
+			t.Error("Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
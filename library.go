@@ -0,0 +1,30 @@
+package myradio
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/UniversityRadioYork/myradio-go/tracks"
+)
+
+// LibraryStats contains summary statistics about the URY track database.
+type LibraryStats = tracks.LibraryStats
+
+// GetLibraryStats gets summary statistics about the track database.
+//
+// This consumes one API request.
+func (s *Session) GetLibraryStats() (*LibraryStats, error) {
+	data, err := s.apiRequest("/track/librarystats", nil)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("No library statistics available")
+	}
+	stats := new(LibraryStats)
+	err = json.Unmarshal(*data, stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
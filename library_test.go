@@ -0,0 +1,15 @@
+package myradio
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetLibraryStatsNullPayload(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, nil)
+	}))
+	if stats, err := s.GetLibraryStats(); err == nil {
+		t.Error("Got:", stats, ", Expected: an error")
+	}
+}
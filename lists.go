@@ -25,15 +25,23 @@ func (s *Session) GetAllLists() ([]List, error) {
 	return lists, nil
 }
 
-func (s *Session) GetMembers(l *List) ([]Member, error) {
-	data, err := s.apiRequest(fmt.Sprintf("/list/%d/members", l.Listid), []string{"personal_data"})
-	if err != nil {
-		return nil, err
-	}
-	var members []Member
-	err = json.Unmarshal(*data, &members)
+// GetListMembersPage gets a page of up to limit members subscribed to the
+// given mailing list, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetListMembersPage(l *List, limit, offset int) (*Page[Member], error) {
+	return getPage[Member](s, fmt.Sprintf("/list/%d/members", l.Listid), []string{"personal_data"}, limit, offset, nil)
+}
+
+// GetListMembers gets the members subscribed to the given mailing list.
+//
+// This consumes one API request.
+func (s *Session) GetListMembers(l *List) ([]Member, error) {
+	page, err := s.GetListMembersPage(l, 0, 0)
 	if err != nil {
 		return nil, err
 	}
-	return members, nil
+	return page.Items, nil
 }
@@ -0,0 +1,53 @@
+package myradio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/UniversityRadioYork/myradio-go/tracks"
+)
+
+// ReplayGainReference is the ReplayGain 2.0 reference loudness, in LUFS.
+const ReplayGainReference = tracks.ReplayGainReference
+
+// TrackLoudness contains loudness normalisation metadata for a track.
+type TrackLoudness = tracks.TrackLoudness
+
+// GetTrackLoudness tries to get the loudness metadata of the track with the given ID.
+//
+// Returns an error if the track has not yet been measured.
+//
+// This consumes one API request.
+func (s *Session) GetTrackLoudness(trackid TrackID) (*TrackLoudness, error) {
+	data, err := s.apiRequest(fmt.Sprintf("/track/%d/loudness", trackid), nil)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("No loudness data set")
+	}
+	loudness := new(TrackLoudness)
+	err = json.Unmarshal(*data, loudness)
+	if err != nil {
+		return nil, err
+	}
+	return loudness, nil
+}
+
+// SetTrackLoudness sets the measured integrated loudness (in LUFS) and true peak
+// (in dBTP) of the track with the given ID.
+//
+// MyRadio derives the ReplayGain values from these measurements.
+//
+// This consumes one API request.
+func (s *Session) SetTrackLoudness(trackid TrackID, lufs, truePeak float64) error {
+	params := url.Values{
+		"integrated_lufs": []string{strconv.FormatFloat(lufs, 'f', -1, 64)},
+		"true_peak":       []string{strconv.FormatFloat(truePeak, 'f', -1, 64)},
+	}
+	_, err := s.apiRequestWithParams("PUT", fmt.Sprintf("/track/%d/loudness", trackid), nil, params)
+	return err
+}
@@ -0,0 +1,52 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetTrackLoudness(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/track/12345/loudness":
+			w.Write([]byte(`{"status":"OK","payload":{"integrated_lufs":-11.5,"true_peak":-0.3,"replaygain_track_gain":-6.5,"replaygain_track_peak":0.966}}`))
+		case "/track/12346/loudness":
+			writePayload(w, nil)
+		default:
+			t.Error("Got request for:", r.URL.Path)
+		}
+	}))
+
+	got, err := s.GetTrackLoudness(12345)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &TrackLoudness{IntegratedLUFS: -11.5, TruePeak: -0.3, TrackGain: -6.5, TrackPeak: 0.966}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got: %+v, Expected: %+v", got, expected)
+	}
+
+	// Unmeasured tracks have no loudness data.
+	if got, err = s.GetTrackLoudness(12346); err == nil {
+		t.Error("Got:", got, ", Expected: an error")
+	}
+}
+
+func TestSetTrackLoudness(t *testing.T) {
+	var got string
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		got = r.Method + " " + r.URL.Path + " " + r.PostForm.Encode()
+		writePayload(w, nil)
+	}))
+
+	if err := s.SetTrackLoudness(12345, -11.5, -0.3); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "PUT /track/12345/loudness integrated_lufs=-11.5&true_peak=-0.3"; got != expected {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+}
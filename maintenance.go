@@ -0,0 +1,29 @@
+package myradio
+
+import (
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/transport"
+)
+
+// ErrMaintenance matches (with errors.Is) any error caused by MyRadio being
+// down for maintenance or in read-only mode.
+//
+// Use errors.As with a *MaintenanceError to find out when to retry.
+var ErrMaintenance = transport.ErrMaintenance
+
+// MaintenanceError is the error returned when MyRadio is down for maintenance
+// or in read-only mode.
+type MaintenanceError = transport.MaintenanceError
+
+// SetMaintenanceRetries makes the Session retry GET requests up to retries
+// times when MyRadio is in maintenance mode.
+//
+// Between attempts, the Session waits for as long as MyRadio estimates the
+// maintenance will last, up to maxWait.
+// Requests that change data are never retried.
+// The default is not to retry, as is a maxWait of zero.
+func (s *Session) SetMaintenanceRetries(retries int, maxWait time.Duration) {
+	s.client.MaintenanceRetries = retries
+	s.client.MaintenanceMaxWait = maxWait
+}
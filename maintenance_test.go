@@ -0,0 +1,72 @@
+package myradio
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMaintenanceRetries(t *testing.T) {
+	var requests int
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"status":"FAIL","payload":"MyRadio is read-only for an upgrade"}`))
+			return
+		}
+		writePayload(w, "Jane Bloggs")
+	}))
+
+	_, err := s.GetUserName(1)
+	var merr *MaintenanceError
+	if !errors.Is(err, ErrMaintenance) || !errors.As(err, &merr) {
+		t.Fatal("Got:", err, ", Expected: a maintenance error")
+	}
+	if merr.RetryAfter != time.Minute || merr.Message != "MyRadio is read-only for an upgrade" {
+		t.Errorf("Got: %+v", merr)
+	}
+
+	s.SetMaintenanceRetries(5, time.Millisecond)
+	name, err := s.GetUserName(1)
+	if err != nil || name != "Jane Bloggs" {
+		t.Error("Got:", name, ", Error:", err)
+	}
+	if requests != 3 {
+		t.Error("Got:", requests, "requests, Expected: 3")
+	}
+}
+
+func TestMaintenanceNotRetried(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		maxWait     time.Duration
+		maintenance bool
+	}{
+		// A 503 from a proxy isn't maintenance, so shouldn't be waited out.
+		{"Proxy", "<html><body>503 Service Unavailable</body></html>", time.Millisecond, false},
+		// Without a maximum wait, there is nothing to wait for.
+		{"NoMaxWait", `{"status":"FAIL","payload":"MyRadio is read-only for an upgrade"}`, 0, true},
+	}
+
+	for _, test := range tests {
+		requests := 0
+		s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(test.body))
+		}))
+		s.SetMaintenanceRetries(5, test.maxWait)
+
+		_, err := s.GetUserName(1)
+		if err == nil || requests != 1 {
+			t.Error(test.name, ", Got:", requests, "requests, Error:", err, ", Expected: 1 request and an error")
+		}
+		if got := errors.Is(err, ErrMaintenance); got != test.maintenance {
+			t.Error(test.name, ", Got maintenance:", got, ", Error:", err)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package myradio
+
+import (
+	"encoding/json"
+
+	"github.com/UniversityRadioYork/myradio-go/internal/timefmt"
+)
+
+// The MarshalJSON methods in this file encode types back into the same form
+// the API uses, so they can be passed on to other consumers.
+//
+// The parsed time fields are the source of truth: each method first
+// regenerates the raw field from its parsed counterpart (if set), so changes
+// made to a parsed field are reflected in the output.
+// The types in the tracks, users and schedule packages do the same.
+
+func (p Podcast) MarshalJSON() ([]byte, error) {
+	type podcast Podcast
+	p.SubmittedRaw = timefmt.Format(p.Submitted, timefmt.DateTime, p.SubmittedRaw)
+	p.PublishTimeRaw = timefmt.Unix(p.PublishTime, p.PublishTimeRaw)
+	return json.Marshal(podcast(p))
+}
+
+func (m TimeslotMessage) MarshalJSON() ([]byte, error) {
+	type timeslotMessage TimeslotMessage
+	m.TimeRaw = timefmt.Unix(m.Time, m.TimeRaw)
+	return json.Marshal(timeslotMessage(m))
+}
+
+func (r CoverRequest) MarshalJSON() ([]byte, error) {
+	type coverRequest CoverRequest
+	r.CreatedRaw = timefmt.Format(r.Created, timefmt.DateTime, r.CreatedRaw)
+	return json.Marshal(coverRequest(r))
+}
+
+func (st SelectorStatus) MarshalJSON() ([]byte, error) {
+	type selectorStatus SelectorStatus
+	st.LastModifiedRaw = timefmt.Unix(st.LastModified, st.LastModifiedRaw)
+	return json.Marshal(selectorStatus(st))
+}
+
+func (e SelectorLockEvent) MarshalJSON() ([]byte, error) {
+	type selectorLockEvent SelectorLockEvent
+	e.TimeRaw = timefmt.Unix(e.Time, e.TimeRaw)
+	return json.Marshal(selectorLockEvent(e))
+}
+
+func (e TracklistEntry) MarshalJSON() ([]byte, error) {
+	type tracklistEntry TracklistEntry
+	e.TimeRaw = timefmt.Unix(e.Time, e.TimeRaw)
+	return json.Marshal(tracklistEntry(e))
+}
+
+func (a SilenceAlarm) MarshalJSON() ([]byte, error) {
+	type silenceAlarm SilenceAlarm
+	a.StartTimeRaw = timefmt.Unix(a.StartTime, a.StartTimeRaw)
+	a.EndTimeRaw = timefmt.Unix(a.EndTime, a.EndTimeRaw)
+	return json.Marshal(silenceAlarm(a))
+}
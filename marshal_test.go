@@ -0,0 +1,109 @@
+package myradio
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestMarshalRoundTrip checks that decoded objects marshal back into the
+// payload of the fixture they came from.
+func TestMarshalRoundTrip(t *testing.T) {
+	tests := []struct {
+		path    string
+		fixture string
+		call    func(s *Session) (interface{}, error)
+	}{
+		{"/track/12345", "track.json", func(s *Session) (interface{}, error) { return s.GetTrack(12345) }},
+		{"/track/12345/album", "album.json", func(s *Session) (interface{}, error) { return s.GetTrackAlbum(12345) }},
+		{"/user/7449/profilephoto/", "profilephoto.json", func(s *Session) (interface{}, error) { return s.GetUserProfilePhoto(7449) }},
+		{"/user/7449/officerships/", "officerships.json", func(s *Session) (interface{}, error) { return s.GetUserOfficerships(7449) }},
+		{"/timeslot/303", "timeslot.json", func(s *Session) (interface{}, error) { return s.GetTimeslot(303) }},
+		{"/timeslot/currentandnext", "currentandnext.json", func(s *Session) (interface{}, error) { return s.GetCurrentAndNext() }},
+		{"/podcast/77", "podcast.json", func(s *Session) (interface{}, error) { return s.GetPodcast(77) }},
+		{"/track/12345/segue", "segue.json", func(s *Session) (interface{}, error) { return s.GetTrackSegue(12345) }},
+	}
+
+	for _, test := range tests {
+		s := newFixtureSession(t, test.path, test.fixture)
+		got, err := test.call(s)
+		if err != nil {
+			t.Error(test.fixture, "Error:", err)
+			continue
+		}
+		data, err := json.Marshal(got)
+		if err != nil {
+			t.Error(test.fixture, "Error:", err)
+			continue
+		}
+
+		raw, err := ioutil.ReadFile("testdata/" + test.fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fixture struct {
+			Payload interface{}
+		}
+		var marshalled interface{}
+		if err = json.Unmarshal(raw, &fixture); err != nil {
+			t.Fatal(err)
+		}
+		if err = json.Unmarshal(data, &marshalled); err != nil {
+			t.Fatal(err)
+		}
+		stripNulls(fixture.Payload)
+		if !reflect.DeepEqual(marshalled, fixture.Payload) {
+			t.Errorf("%s\nGot:      %s\nExpected: %s", test.fixture, data, raw)
+		}
+	}
+}
+
+// stripNulls removes null members from decoded JSON objects, as these are
+// omitted when marshalling.
+func stripNulls(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e == nil {
+				delete(v, k)
+			} else {
+				stripNulls(e)
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			stripNulls(e)
+		}
+	}
+}
+
+func TestMarshalUsesParsedTimes(t *testing.T) {
+	ts := Timeslot{
+		Season: Season{
+			SeasonID:     202,
+			FirstTimeRaw: "05/10/2015 07:00",
+			FirstTime:    time.Date(2015, 10, 12, 8, 0, 0, 0, time.UTC),
+		},
+		TimeslotID: 303,
+		StartTime:  time.Date(2015, 10, 26, 7, 0, 0, 0, time.UTC),
+		Duration:   90 * time.Minute,
+	}
+	data, err := json.Marshal(ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{`"season_id":202`, `"timeslot_id":303`, `"first_time":"12/10/2015 08:00"`, `"start_time":"26/10/2015 07:00"`, `"duration":"01:30:00"`} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("Marshalled Timeslot missing %s: %s", expected, data)
+		}
+	}
+	for _, unexpected := range []string{"FirstTime", "StartTime", "Duration", "Raw"} {
+		if strings.Contains(string(data), unexpected) {
+			t.Errorf("Marshalled Timeslot leaks %s: %s", unexpected, data)
+		}
+	}
+}
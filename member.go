@@ -3,17 +3,14 @@ package myradio
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/UniversityRadioYork/myradio-go/users"
 )
 
-type Member struct {
-	Memberid     int
-	Fname, Sname string
-	Sex          string
-	Email        string `json:"public_email"`
-	Receiveemail bool   `json:"receive_email"`
-}
+// Member is a MyRadio user.
+type Member = users.Member
 
-func (s *Session) GetMember(id int) (*Member, error) {
+func (s *Session) GetMember(id UserID) (*Member, error) {
 	data, err := s.apiRequest(fmt.Sprintf("/user/%d", id), []string{"personal_data"})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,209 @@
+// Package myradiotest provides tools for testing programs that use the
+// myradio package.
+//
+// Its Transport injects faults into requests to MyRadio, so that programs
+// can check how they cope with the ways MyRadio really fails, without a
+// staging server:
+//
+//	ft := myradiotest.NewTransport(nil)
+//	ft.Inject("/timeslot/currentandnext", myradiotest.Fault{Latency: 5 * time.Second})
+//	session, err := myradio.NewSession(key, myradio.WithHTTPClient(&http.Client{Transport: ft}))
+package myradiotest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Fault describes what goes wrong with a request.
+//
+// A Fault can combine several failures; the latency is added first, and a
+// rate limited request is not passed on to the API at all.
+type Fault struct {
+	// Latency is how long the request is delayed before it is sent.
+	Latency time.Duration
+	// MalformedJSON prefixes the response body with a PHP warning, as MyRadio
+	// does when something goes wrong in the middle of an endpoint.
+	MalformedJSON bool
+	// Truncate cuts the response body off halfway through, as when a
+	// connection drops.
+	Truncate bool
+	// RateLimit responds with 429 Too Many Requests, telling the client to
+	// retry after RetryAfter (rounded down to whole seconds).
+	RateLimit  bool
+	RetryAfter time.Duration
+	// Times is how many requests the Fault applies to, after which it is
+	// removed, or zero to apply it to every matching request.
+	Times int
+}
+
+// phpWarning is what MyRadio outputs ahead of the JSON when PHP emits a warning.
+const phpWarning = "<br />\n<b>Warning</b>:  Undefined array key \"payload\" in <b>/var/www/myradio/src/Classes/ServiceAPI/ServiceAPI.php</b> on line <b>42</b><br />\n"
+
+// rateLimitBody is the body of a rate limited response.
+const rateLimitBody = `{"status":"FAIL","payload":"You have exceeded your request quota. Please slow down."}`
+
+type rule struct {
+	pattern []string
+	fault   Fault
+	left    int
+}
+
+// Transport is an http.RoundTripper that injects Faults into the requests
+// it sends for chosen endpoints.
+//
+// It is safe to use from multiple goroutines, and to inject and clear faults
+// while it is in use.
+type Transport struct {
+	// Base sends the requests; if nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+
+	mu    sync.Mutex
+	rules []*rule
+}
+
+// NewTransport creates a Transport sending requests with the given base
+// RoundTripper, or http.DefaultTransport if it is nil.
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{Base: base}
+}
+
+// Inject applies the given Fault to requests for the given endpoint.
+//
+// The endpoint is written as it is in the myradio package, for example
+// "/track/1234/album"; a path element can be "*" to match any value, as in
+// "/timeslot/*/tracklist", and the empty endpoint matches every request.
+// The endpoint is matched against the end of the request's path, so that
+// the API's base URL does not matter.
+//
+// If several Faults match a request, the one injected first applies.
+func (t *Transport) Inject(endpoint string, f Fault) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rules = append(t.rules, &rule{pattern: splitPath(endpoint), fault: f, left: f.Times})
+}
+
+// Clear removes all injected Faults.
+func (t *Transport) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rules = nil
+}
+
+// splitPath splits a URL path into its elements, ignoring leading and
+// trailing slashes.
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// matches returns true if the rule applies to the given request path.
+func (r *rule) matches(elems []string) bool {
+	if len(r.pattern) > len(elems) {
+		return false
+	}
+	elems = elems[len(elems)-len(r.pattern):]
+	for i, p := range r.pattern {
+		if ok, _ := path.Match(p, elems[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// fault returns the Fault to apply to the given request, if any, using it up.
+func (t *Transport) fault(req *http.Request) (Fault, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	elems := splitPath(req.URL.Path)
+	for i, r := range t.rules {
+		if !r.matches(elems) {
+			continue
+		}
+		if r.left > 0 {
+			r.left--
+			if r.left == 0 {
+				t.rules = append(t.rules[:i:i], t.rules[i+1:]...)
+			}
+		}
+		return r.fault, true
+	}
+	return Fault{}, false
+}
+
+// RoundTrip sends the request, applying any Fault injected for its endpoint.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	f, ok := t.fault(req)
+	if !ok {
+		return base.RoundTrip(req)
+	}
+
+	if f.Latency > 0 {
+		timer := time.NewTimer(f.Latency)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+	if f.RateLimit {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return rateLimited(req, f.RetryAfter), nil
+	}
+
+	res, err := base.RoundTrip(req)
+	if err != nil || !(f.MalformedJSON || f.Truncate) {
+		return res, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if f.Truncate {
+		body = body[:len(body)/2]
+	}
+	if f.MalformedJSON {
+		body = append([]byte(phpWarning), body...)
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	res.ContentLength = int64(len(body))
+	res.Header.Del("Content-Length")
+	return res, nil
+}
+
+// rateLimited creates a 429 response to the given request.
+func rateLimited(req *http.Request, retryAfter time.Duration) *http.Response {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	if retryAfter > 0 {
+		header.Set("Retry-After", strconv.Itoa(int(retryAfter/time.Second)))
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", http.StatusTooManyRequests, http.StatusText(http.StatusTooManyRequests)),
+		StatusCode:    http.StatusTooManyRequests,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(rateLimitBody)),
+		ContentLength: int64(len(rateLimitBody)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,90 @@
+package myradiotest_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go"
+	"github.com/UniversityRadioYork/myradio-go/myradiotest"
+)
+
+// newFaultySession creates a Session for a fake API, which names every
+// user "Jane Doe", sending requests through the given Transport.
+func newFaultySession(t *testing.T, ft *myradiotest.Transport, opts ...myradio.Option) *myradio.Session {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status":  "OK",
+			"payload": "Jane Doe",
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	opts = append([]myradio.Option{myradio.WithBaseURL(srv.URL + "/api/v2"), myradio.WithHTTPClient(&http.Client{Transport: ft})}, opts...)
+	s, err := myradio.NewSession("TEST-KEY", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestTransportFaults(t *testing.T) {
+	tests := []struct {
+		name string
+		// endpoint is the endpoint the fault is injected for.
+		endpoint string
+		fault    myradiotest.Fault
+		check    func(err error) bool
+	}{
+		{"Latency", "/user/*/name", myradiotest.Fault{Latency: time.Second}, func(err error) bool {
+			return err != nil
+		}},
+		{"MalformedJSON", "/user/1/name", myradiotest.Fault{MalformedJSON: true}, func(err error) bool {
+			var serr *json.SyntaxError
+			return errors.As(err, &serr)
+		}},
+		{"Truncate", "/name", myradiotest.Fault{Truncate: true}, func(err error) bool {
+			return err != nil
+		}},
+		{"RateLimit", "", myradiotest.Fault{RateLimit: true, RetryAfter: 30 * time.Second}, func(err error) bool {
+			var aerr *myradio.APIError
+			return errors.As(err, &aerr) && aerr.StatusCode == http.StatusTooManyRequests
+		}},
+		{"OtherEndpoint", "/user/2/name", myradiotest.Fault{RateLimit: true}, func(err error) bool {
+			return err == nil
+		}},
+	}
+
+	for _, test := range tests {
+		ft := myradiotest.NewTransport(nil)
+		s := newFaultySession(t, ft, myradio.WithTimeout(100*time.Millisecond))
+		ft.Inject(test.endpoint, test.fault)
+		_, err := s.GetUserName(1)
+		if !test.check(err) {
+			t.Error(test.name, ", Got error:", err)
+		}
+	}
+}
+
+func TestTransportFaultTimes(t *testing.T) {
+	ft := myradiotest.NewTransport(nil)
+	s := newFaultySession(t, ft)
+	ft.Inject("/user/*/name", myradiotest.Fault{RateLimit: true, Times: 2})
+
+	expected := []bool{false, false, true}
+	for i, ok := range expected {
+		name, err := s.GetUserName(1)
+		if (err == nil) != ok {
+			t.Error("Request", i, ", Got:", name, err, ", Expected success:", ok)
+		}
+	}
+
+	ft.Inject("", myradiotest.Fault{Truncate: true})
+	ft.Clear()
+	if _, err := s.GetUserName(1); err != nil {
+		t.Error("Got:", err, ", Expected: no faults after Clear")
+	}
+}
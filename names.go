@@ -0,0 +1,128 @@
+package myradio
+
+import (
+	"sync"
+)
+
+// NameResolver resolves member IDs to names, caching the results.
+//
+// It is safe for concurrent use: simultaneous lookups of the same ID share
+// a single API request.
+type NameResolver struct {
+	session *Session
+
+	mu       sync.Mutex
+	names    map[UserID]string
+	inflight map[UserID]*nameCall
+}
+
+// nameCall is an in-progress name lookup, shared by all callers waiting on it.
+type nameCall struct {
+	done chan struct{}
+	name string
+	err  error
+}
+
+// NewNameResolver creates a NameResolver that looks up names using the given Session.
+func NewNameResolver(s *Session) *NameResolver {
+	return &NameResolver{
+		session:  s,
+		names:    make(map[UserID]string),
+		inflight: make(map[UserID]*nameCall),
+	}
+}
+
+// Name gets the name of the member with the given ID.
+//
+// This consumes one API request, unless the name is already cached or
+// already being looked up.
+// Failed lookups are not cached.
+func (r *NameResolver) Name(id UserID) (string, error) {
+	r.mu.Lock()
+	if name, ok := r.names[id]; ok {
+		r.mu.Unlock()
+		return name, nil
+	}
+	if call, ok := r.inflight[id]; ok {
+		r.mu.Unlock()
+		<-call.done
+		return call.name, call.err
+	}
+	call := &nameCall{done: make(chan struct{})}
+	r.inflight[id] = call
+	r.mu.Unlock()
+
+	call.name, call.err = r.session.GetUserName(id)
+
+	r.mu.Lock()
+	if call.err == nil {
+		r.names[id] = call.name
+	}
+	delete(r.inflight, id)
+	r.mu.Unlock()
+	close(call.done)
+
+	return call.name, call.err
+}
+
+// Names gets the names of all members with the given IDs, as a map from ID to name.
+//
+// Lookups happen in parallel, at most MaxParallelLookups at a time, and each
+// distinct uncached ID consumes one API request.
+// If any lookup fails, one of the errors is returned along with the names that
+// were resolved successfully.
+func (r *NameResolver) Names(ids []UserID) (map[UserID]string, error) {
+	return lookupMembers(ids, r.Name)
+}
+
+// Forget removes any cached name for the member with the given ID.
+func (r *NameResolver) Forget(id UserID) {
+	r.mu.Lock()
+	delete(r.names, id)
+	r.mu.Unlock()
+}
+
+// MaxParallelLookups is the most API requests made at once when looking up
+// several members, as by NameResolver.Names and Session.ResolvePhotoOwners.
+const MaxParallelLookups = 8
+
+// lookupMembers calls lookup once for each distinct ID in ids, at most
+// MaxParallelLookups at a time, and returns the successful results by ID
+// along with the first error, if any.
+func lookupMembers[V any](ids []UserID, lookup func(UserID) (V, error)) (map[UserID]V, error) {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	results := make(map[UserID]V, len(ids))
+	seen := make(map[UserID]bool, len(ids))
+	sem := make(chan struct{}, MaxParallelLookups)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id UserID) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			v, err := lookup(id)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			results[id] = v
+		}(id)
+	}
+	wg.Wait()
+	return results, firstErr
+}
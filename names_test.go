@@ -0,0 +1,86 @@
+package myradio
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNameResolver(t *testing.T) {
+	var requests int32
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		// Give concurrent lookups a chance to pile up on the same request.
+		time.Sleep(10 * time.Millisecond)
+		var id int
+		fmt.Sscanf(r.URL.Path, "/user/%d/name/", &id)
+		writePayload(w, fmt.Sprintf("Member %d", id))
+	}))
+	r := NewNameResolver(s)
+
+	ids := []UserID{1, 2, 3, 1, 2, 3, 1}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			names, err := r.Names(ids)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			for _, id := range ids {
+				if expected := fmt.Sprintf("Member %d", id); names[id] != expected {
+					t.Errorf("Got: %q, Expected: %q", names[id], expected)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Error("Got:", got, "requests, Expected: 3")
+	}
+
+	r.Forget(1)
+	if _, err := r.Name(1); err != nil {
+		t.Error(err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 4 {
+		t.Error("Got:", got, "requests, Expected: 4")
+	}
+}
+
+func TestNamesBounded(t *testing.T) {
+	var inflight, peak int32
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inflight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&inflight, -1)
+		writePayload(w, "Jane Bloggs")
+	}))
+
+	ids := make([]UserID, 4*MaxParallelLookups)
+	for k := range ids {
+		ids[k] = UserID(k + 1)
+	}
+	names, err := NewNameResolver(s).Names(ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != len(ids) {
+		t.Error("Got:", len(names), "names, Expected:", len(ids))
+	}
+	if got := atomic.LoadInt32(&peak); got > MaxParallelLookups {
+		t.Error("Got:", got, "requests at once, Expected at most:", MaxParallelLookups)
+	}
+}
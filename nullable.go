@@ -0,0 +1,15 @@
+package myradio
+
+import "github.com/UniversityRadioYork/myradio-go/tracks"
+
+// Nullable is a value that MyRadio may leave null, such as the shelf
+// location of an album with no physical copy.
+//
+// Unlike decoding into a plain T, this keeps a null (or missing) value
+// distinguishable from a real zero value.
+type Nullable[T any] = tracks.Nullable[T]
+
+// NewNullable returns a Nullable set to the given value.
+func NewNullable[T any](v T) Nullable[T] {
+	return tracks.NewNullable(v)
+}
@@ -0,0 +1,117 @@
+package myradio
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/users"
+)
+
+// Team is a team of officers, such as Computing.
+type Team = users.Team
+
+// Officer is an officer position, which may or may not currently be filled.
+type Officer = users.Officer
+
+// OfficerHolder is a member's tenure in an officer position.
+type OfficerHolder = users.OfficerHolder
+
+// GetAllOfficersPage gets a page of up to limit officer positions, along
+// with their current holders, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetAllOfficersPage(limit, offset int) (*Page[Officer], error) {
+	return getPage[Officer](s, "/officer/allofficers/", []string{"current"}, limit, offset, nil)
+}
+
+// GetAllOfficers gets all officer positions, along with their current holders.
+//
+// This consumes one API request.
+func (s *Session) GetAllOfficers() ([]Officer, error) {
+	page, err := s.GetAllOfficersPage(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// GetVacantOfficerPositions gets all current officer positions with nobody holding them.
+//
+// This consumes one API request.
+func (s *Session) GetVacantOfficerPositions() ([]Officer, error) {
+	officers, err := s.GetAllOfficers()
+	if err != nil {
+		return nil, err
+	}
+	var vacant []Officer
+	for _, o := range officers {
+		if o.Status == "c" && len(o.Current) == 0 {
+			vacant = append(vacant, o)
+		}
+	}
+	return vacant, nil
+}
+
+// GetOfficerHistory gets everyone who has held the officer position with the given ID.
+//
+// This consumes one API request.
+func (s *Session) GetOfficerHistory(officerid OfficerID) (history []OfficerHolder, err error) {
+	data, err := s.apiRequest(fmt.Sprintf("/officer/%d/history/", officerid), []string{})
+	if err != nil || data == nil {
+		return
+	}
+	err = json.Unmarshal(*data, &history)
+	if err != nil {
+		return
+	}
+	err = parseOfficerHolderDates(history)
+	return
+}
+
+// parseOfficerHolderDates fills in the parsed dates of each OfficerHolder.
+func parseOfficerHolderDates(holders []OfficerHolder) (err error) {
+	for k, v := range holders {
+		holders[k].FromDate, err = time.Parse(dateLayout, v.FromDateRaw)
+		if err != nil {
+			return
+		}
+		if v.TillDateRaw != "" {
+			holders[k].TillDate, err = time.Parse(dateLayout, v.TillDateRaw)
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// GetTeamCurrentMembers gets everyone currently holding a position in the team with the given ID.
+//
+// This consumes one API request.
+func (s *Session) GetTeamCurrentMembers(teamid TeamID) ([]OfficerHolder, error) {
+	return s.getTeamHolders(fmt.Sprintf("/team/%d/currentholders/", teamid))
+}
+
+// GetTeamHistory gets everyone who has held a position in the team with the given ID.
+//
+// This consumes one API request.
+func (s *Session) GetTeamHistory(teamid TeamID) ([]OfficerHolder, error) {
+	return s.getTeamHolders(fmt.Sprintf("/team/%d/history/", teamid))
+}
+
+// getTeamHolders gets the officer holders listed at the given team endpoint.
+func (s *Session) getTeamHolders(endpoint string) (holders []OfficerHolder, err error) {
+	data, err := s.apiRequest(endpoint, []string{})
+	if err != nil || data == nil {
+		return
+	}
+	err = json.Unmarshal(*data, &holders)
+	if err != nil {
+		return
+	}
+	err = parseOfficerHolderDates(holders)
+	return
+}
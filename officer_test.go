@@ -0,0 +1,25 @@
+package myradio
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestOfficersNullPayload(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, nil)
+	}))
+
+	officers, err := s.GetAllOfficers()
+	if err != nil || len(officers) != 0 {
+		t.Error("Got:", officers, ", Error:", err, ", Expected: no officers")
+	}
+	history, err := s.GetOfficerHistory(12)
+	if err != nil || len(history) != 0 {
+		t.Error("Got:", history, ", Error:", err, ", Expected: no history")
+	}
+	holders, err := s.GetTeamCurrentMembers(4)
+	if err != nil || len(holders) != 0 {
+		t.Error("Got:", holders, ", Error:", err, ", Expected: no holders")
+	}
+}
@@ -0,0 +1,178 @@
+package myradio
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/transport"
+)
+
+// Option is a setting that can be applied to a Session when it is created or cloned.
+type Option func(*Session) error
+
+// WithAPIKey makes a Session use the given API key.
+func WithAPIKey(apikey string) Option {
+	return func(s *Session) error {
+		s.client.APIKey = apikey
+		return nil
+	}
+}
+
+// WithTokenSource makes a Session send a bearer token from the given
+// TokenSource with every request, so that it acts with the permissions of
+// the user the token was issued to rather than those of an API key.
+//
+// To use only the token, create the Session with an empty API key:
+//
+//	session, err := myradio.NewSession("", myradio.WithTokenSource(ts))
+func WithTokenSource(ts TokenSource) Option {
+	return func(s *Session) error {
+		s.client.TokenSource = ts
+		return nil
+	}
+}
+
+// WithHTTPClient makes a Session send requests using (a copy of) the given
+// HTTP client.
+//
+// This allows clients that authenticate requests themselves, such as those
+// from golang.org/x/oauth2's Config.Client, to be used in place of
+// WithTokenSource.
+// Options such as WithTimeout that change the HTTP client should be given
+// after this one.
+func WithHTTPClient(c *http.Client) Option {
+	return func(s *Session) error {
+		httpClient := *c
+		s.client.HTTPClient = &httpClient
+		return nil
+	}
+}
+
+// WithBaseURL makes a Session send requests to the API at the given URL,
+// for example "https://ury.york.ac.uk/api/v2".
+func WithBaseURL(baseurl string) Option {
+	return func(s *Session) error {
+		u, err := url.Parse(baseurl)
+		if err != nil {
+			return err
+		}
+		s.client.BaseURL = *u
+		return nil
+	}
+}
+
+// WithTimeout makes a Session give up on requests that take longer than the
+// given duration.
+// Zero means no timeout, which is the default.
+func WithTimeout(d time.Duration) Option {
+	return func(s *Session) error {
+		s.client.HTTPClient.Timeout = d
+		return nil
+	}
+}
+
+// WithUserAgent identifies requests from a Session as coming from the given
+// application, for example "aliasgen/1.2".
+//
+// The application is sent in the User-Agent header ahead of the library's
+// own DefaultUserAgent, so both show up in MyRadio's logs.
+func WithUserAgent(app string) Option {
+	return func(s *Session) error {
+		s.client.UserAgent = app + " " + DefaultUserAgent
+		return nil
+	}
+}
+
+// WithHeader makes a Session send the given header, with the given value,
+// with every request, replacing any value it was set to before.
+//
+// Combined with Clone, this can propagate trace or correlation IDs from an
+// incoming request to the MyRadio requests made while handling it:
+//
+//	reqSession, err := session.Clone(myradio.WithHeader("X-Correlation-ID", r.Header.Get("X-Correlation-ID")))
+func WithHeader(key, value string) Option {
+	return func(s *Session) error {
+		if s.client.Header == nil {
+			s.client.Header = make(http.Header)
+		}
+		s.client.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithRequestIDs makes a Session send a unique ID with each request, in the
+// X-Request-ID header, so it can be found in MyRadio's logs.
+//
+// IDs come from the given generator, or are random if it is nil.
+// A request ID set with WithHeader takes precedence.
+func WithRequestIDs(gen func() string) Option {
+	return func(s *Session) error {
+		if gen == nil {
+			gen = transport.NewRequestID
+		}
+		s.client.RequestID = gen
+		return nil
+	}
+}
+
+// WithLogger makes a Session log warnings, such as uses of deprecated
+// methods, to the given logger.
+//
+// By default, nothing is logged.
+func WithLogger(l *log.Logger) Option {
+	return func(s *Session) error {
+		s.logger = l
+		s.warned = new(sync.Map)
+		return nil
+	}
+}
+
+// apply applies the given Options to the Session, in order.
+func (s *Session) apply(opts []Option) error {
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithMirrors gives a Session mirrors of the API to fail over to when its
+// base URL is unavailable, for example while the main web server reboots.
+//
+// Mirrors are tried in the given order.
+// Any URL that fails is passed over for a while in favour of the next one,
+// but is still tried as a last resort if everything else has failed too.
+func WithMirrors(mirrors ...string) Option {
+	return func(s *Session) error {
+		for _, m := range mirrors {
+			u, err := url.Parse(m)
+			if err != nil {
+				return err
+			}
+			s.client.Mirrors = append(s.client.Mirrors, *u)
+		}
+		return nil
+	}
+}
+
+// Clone creates a copy of the Session with the given Options applied.
+//
+// The copy shares the original's HTTP transport, and so its connection
+// pool, which makes cloning cheap enough to do per tenant (or per request).
+// It also shares the original's record of which mirrors are unavailable.
+// Changing settings on one Session does not affect the other.
+func (s *Session) Clone(opts ...Option) (*Session, error) {
+	c := &Session{
+		client: s.client.Clone(),
+		logger: s.logger,
+		warned: s.warned,
+	}
+	if err := c.apply(opts); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,235 @@
+package myradio
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClone(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, r.FormValue("api_key"))
+	}))
+
+	c, err := s.Clone(WithAPIKey("PARTNER-KEY"), WithTimeout(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		session         *Session
+		expectedKey     string
+		expectedTimeout time.Duration
+	}{
+		{s, "TEST-KEY", 0},
+		{c, "PARTNER-KEY", time.Second},
+	}
+
+	for _, test := range tests {
+		key, err := test.session.GetUserName(1)
+		if err != nil || key != test.expectedKey {
+			t.Error("Got key:", key, ", Expected:", test.expectedKey, ", Error:", err)
+		}
+		if test.session.client.HTTPClient.Timeout != test.expectedTimeout {
+			t.Error("Got timeout:", test.session.client.HTTPClient.Timeout, ", Expected:", test.expectedTimeout)
+		}
+	}
+	if c.client.HTTPClient.Transport != s.client.HTTPClient.Transport {
+		t.Error("Clone did not share the transport")
+	}
+
+	if _, err = s.Clone(WithBaseURL("://bad")); err == nil {
+		t.Error("Expected an error for a bad base URL")
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	var got string
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.UserAgent()
+		writePayload(w, "")
+	}))
+
+	tests := []struct {
+		opts     []Option
+		expected string
+	}{
+		{nil, DefaultUserAgent},
+		{[]Option{WithUserAgent("aliasgen/1.2")}, "aliasgen/1.2 " + DefaultUserAgent},
+	}
+
+	for _, test := range tests {
+		c, err := s.Clone(test.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.GetUserName(1); err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Error("Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
+
+func TestDefaultHeaders(t *testing.T) {
+	var got []http.Header
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header)
+		writePayload(w, "")
+	}))
+
+	ids := 0
+	c, err := s.Clone(WithHeader("X-Correlation-ID", "abc"), WithRequestIDs(func() string {
+		ids++
+		return fmt.Sprint("req-", ids)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err = c.GetUserName(1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	traced, err := c.Clone(WithHeader("X-Request-ID", "fixed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = traced.GetUserName(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.GetUserName(1); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		correlationID, requestID string
+	}{
+		{"abc", "req-1"},
+		{"abc", "req-2"},
+		{"abc", "fixed"},
+		{"", ""},
+	}
+	for i, test := range tests {
+		if got[i].Get("X-Correlation-ID") != test.correlationID || got[i].Get("X-Request-ID") != test.requestID {
+			t.Error("Request", i, ", Got:", got[i], ", Expected:", test.correlationID, test.requestID)
+		}
+		if got[i].Get("User-Agent") != DefaultUserAgent {
+			t.Error("Request", i, ", Got User-Agent:", got[i].Get("User-Agent"))
+		}
+	}
+}
+
+func TestRandomRequestIDs(t *testing.T) {
+	var got []string
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("X-Request-ID"))
+		writePayload(w, "")
+	}))
+	c, err := s.Clone(WithRequestIDs(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err = c.GetUserName(1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(got) != 2 || len(got[0]) != 32 || got[0] == got[1] {
+		t.Error("Got:", got, ", Expected: two different random IDs")
+	}
+}
+
+func TestWithTokenSource(t *testing.T) {
+	var got []*http.Request
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r)
+		writePayload(w, "")
+	}))
+
+	tokens := 0
+	c, err := s.Clone(WithAPIKey(""), WithTokenSource(TokenSourceFunc(func() (string, error) {
+		tokens++
+		return fmt.Sprint("token-", tokens), nil
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err = c.GetUserName(1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = s.GetUserName(1); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		authorization string
+		apiKey        []string
+	}{
+		{"Bearer token-1", nil},
+		{"Bearer token-2", nil},
+		{"", []string{"TEST-KEY"}},
+	}
+	for i, test := range tests {
+		if auth := got[i].Header.Get("Authorization"); auth != test.authorization {
+			t.Error("Request", i, ", Got Authorization:", auth, ", Expected:", test.authorization)
+		}
+		if key := got[i].URL.Query()["api_key"]; !reflect.DeepEqual(key, test.apiKey) {
+			t.Error("Request", i, ", Got api_key:", key, ", Expected:", test.apiKey)
+		}
+	}
+
+	expired := errors.New("token expired")
+	failing, err := s.Clone(WithTokenSource(TokenSourceFunc(func() (string, error) {
+		return "", expired
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = failing.GetUserName(1); err != expired {
+		t.Error("Got:", err, ", Expected:", expired)
+	}
+	if len(got) != 3 {
+		t.Error("Got:", len(got), "requests, Expected: no request without a token")
+	}
+}
+
+// headerTransport adds a header to every request, like an OAuth client.
+type headerTransport struct {
+	key, value string
+}
+
+func (h headerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set(h.key, h.value)
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	var got string
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		writePayload(w, "")
+	}))
+
+	httpClient := &http.Client{Transport: headerTransport{"Authorization", "Bearer oauth"}}
+	c, err := s.Clone(WithHTTPClient(httpClient), WithTimeout(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.GetUserName(1); err != nil {
+		t.Fatal(err)
+	}
+	if got != "Bearer oauth" {
+		t.Error("Got:", got, ", Expected: Bearer oauth")
+	}
+	if httpClient.Timeout != 0 {
+		t.Error("Got timeout:", httpClient.Timeout, ", Expected: the given client to be left alone")
+	}
+}
@@ -0,0 +1,120 @@
+package myradio
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// Page is one page of a listing from MyRadio, along with what is known about
+// the rest of the listing.
+type Page[T any] struct {
+	Items []T
+	// Total is the number of items in the whole listing, or -1 if MyRadio didn't say.
+	Total int
+	// HasMore is true if there may be items after this page.
+	HasMore bool
+	// NextOffset is the offset of the next page, if HasMore is true.
+	NextOffset int
+}
+
+// pageEnvelope is how MyRadio returns a page of a listing when it knows the total.
+type pageEnvelope[T any] struct {
+	Items []T  `json:"items"`
+	Total *int `json:"total"`
+}
+
+// pageParams returns the parameters asking for the given page of a listing.
+//
+// A limit of 0 means no limit.
+func pageParams(limit, offset int) url.Values {
+	params := url.Values{}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		params.Set("offset", strconv.Itoa(offset))
+	}
+	return params
+}
+
+// getPage gets a page of up to limit items of a listing from the given
+// endpoint, skipping the first offset.
+//
+// This consumes one API request.
+func getPage[T any](s *Session, endpoint string, mixins []string, limit, offset int, params url.Values) (*Page[T], error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	for k, v := range pageParams(limit, offset) {
+		params[k] = v
+	}
+	data, err := s.apiRequestWithParams("GET", endpoint, mixins, params)
+	if err != nil {
+		return nil, err
+	}
+	return decodePage[T](data, limit, offset)
+}
+
+// decodePage decodes a page of a listing, asked for with the given limit and offset.
+//
+// The payload may be either a bare array of items or an object with the
+// items and the total; with a bare array, there are assumed to be more
+// items if the page is full.
+func decodePage[T any](data *json.RawMessage, limit, offset int) (*Page[T], error) {
+	page := &Page[T]{Total: -1}
+	if data != nil && bytes.HasPrefix(bytes.TrimSpace(*data), []byte("{")) {
+		var envelope pageEnvelope[T]
+		if err := json.Unmarshal(*data, &envelope); err != nil {
+			return nil, err
+		}
+		page.Items = envelope.Items
+		if envelope.Total != nil {
+			page.Total = *envelope.Total
+		}
+	} else if data != nil {
+		if err := json.Unmarshal(*data, &page.Items); err != nil {
+			return nil, err
+		}
+	}
+
+	page.NextOffset = offset + len(page.Items)
+	switch {
+	case page.Total >= 0:
+		page.HasMore = page.NextOffset < page.Total
+	case limit <= 0:
+		// Everything from the offset onwards was asked for, so this is the lot.
+		page.Total = page.NextOffset
+	default:
+		page.HasMore = len(page.Items) == limit
+	}
+	return page, nil
+}
+
+// AllPages gets every item in a listing, by calling fetch for successive
+// pages of up to pageSize items until there are no more.
+//
+// For example:
+//
+//	tracks, err := myradio.AllPages(func(limit, offset int) (*myradio.Page[myradio.Track], error) {
+//		return session.GetTracksByArtistPage("Oasis", limit, offset)
+//	}, 100)
+//
+// This consumes one API request for each page.
+func AllPages[T any](fetch func(limit, offset int) (*Page[T], error), pageSize int) ([]T, error) {
+	var items []T
+	offset := 0
+	for {
+		page, err := fetch(pageSize, offset)
+		if err != nil {
+			return items, err
+		}
+		items = append(items, page.Items...)
+		// Stop on an empty page too, in case MyRadio's total is wrong.
+		if !page.HasMore || len(page.Items) == 0 {
+			return items, nil
+		}
+		offset = page.NextOffset
+	}
+}
@@ -0,0 +1,133 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestDecodePage(t *testing.T) {
+	tests := []struct {
+		payload       string
+		limit, offset int
+		expected      Page[int]
+	}{
+		{`[1, 2]`, 2, 0, Page[int]{Items: []int{1, 2}, Total: -1, HasMore: true, NextOffset: 2}},
+		{`[3]`, 2, 2, Page[int]{Items: []int{3}, Total: -1, NextOffset: 3}},
+		{`[1, 2, 3]`, 0, 0, Page[int]{Items: []int{1, 2, 3}, Total: 3, NextOffset: 3}},
+		{`{"items": [3, 4], "total": 5}`, 2, 2, Page[int]{Items: []int{3, 4}, Total: 5, HasMore: true, NextOffset: 4}},
+		{`{"items": [5], "total": 5}`, 2, 4, Page[int]{Items: []int{5}, Total: 5, NextOffset: 5}},
+		{`{"items": [1, 2]}`, 2, 0, Page[int]{Items: []int{1, 2}, Total: -1, HasMore: true, NextOffset: 2}},
+	}
+
+	for _, test := range tests {
+		got, err := decodePage[int](rawMessage(test.payload), test.limit, test.offset)
+		if err != nil || !reflect.DeepEqual(*got, test.expected) {
+			t.Error("Payload:", test.payload, ", Got:", got, ", Error:", err, ", Expected:", test.expected)
+		}
+	}
+
+	if got, err := decodePage[int](nil, 2, 0); err != nil || len(got.Items) != 0 || got.HasMore {
+		t.Error("Got:", got, ", Error:", err, ", Expected: an empty page")
+	}
+}
+
+func TestAllPages(t *testing.T) {
+	requests := 0
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.FormValue("offset"))
+		tracks := []Track{testTrack, testTrack, testTrack, testTrack, testTrack}[offset:]
+		if len(tracks) > 2 {
+			tracks = tracks[:2]
+		}
+		writePayload(w, map[string]interface{}{"items": tracks, "total": 5})
+	}))
+
+	tracks, err := AllPages(func(limit, offset int) (*Page[Track], error) {
+		return s.GetTracksByRecordPage(54321, limit, offset)
+	}, 2)
+	if err != nil || len(tracks) != 5 || requests != 3 {
+		t.Error("Got:", len(tracks), "tracks in", requests, "requests, Error:", err, ", Expected: 5 in 3")
+	}
+}
+
+func TestCollectionPages(t *testing.T) {
+	var got string
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		r.Form.Del("api_key")
+		got = r.URL.Path + " " + r.Form.Encode()
+		// Every listing's times must parse, so the items carry all of them.
+		item := map[string]string{
+			"first_time": "03/10/2016 19:00",
+			"submitted":  "01/09/2016 12:00",
+			"start_time": "03/10/2016 19:00",
+			"duration":   "01:00:00",
+			"created":    "02/10/2016 09:30",
+			"date_added": "01/09/2016 12:00",
+		}
+		writePayload(w, map[string]interface{}{"items": []interface{}{item, item}, "total": 5})
+	}))
+
+	tests := []struct {
+		fetch    func() (int, int, error)
+		expected string
+	}{
+		{func() (int, int, error) {
+			page, err := s.GetListMembersPage(&List{Listid: 7}, 2, 2)
+			return len(page.Items), page.Total, err
+		}, "/list/7/members limit=2&mixins=personal_data&offset=2"},
+		{func() (int, int, error) {
+			page, err := s.GetAllOfficersPage(2, 2)
+			return len(page.Items), page.Total, err
+		}, "/officer/allofficers/ limit=2&mixins=current&offset=2"},
+		{func() (int, int, error) {
+			page, err := s.GetAllShowsPage(true, 2, 2)
+			return len(page.Items), page.Total, err
+		}, "/show/allshows current_term_only=1&limit=2&mixins=credits&offset=2"},
+		{func() (int, int, error) {
+			page, err := s.GetShowPodcastsPage(101, 2, 2)
+			return len(page.Items), page.Total, err
+		}, "/show/101/allpodcasts limit=2&offset=2"},
+		{func() (int, int, error) {
+			page, err := s.GetSeasonsPage(101, 2, 2)
+			return len(page.Items), page.Total, err
+		}, "/show/101/allseasons limit=2&offset=2"},
+		{func() (int, int, error) {
+			page, err := s.GetTimeslotsForSeasonPage(202, 2, 2)
+			return len(page.Items), page.Total, err
+		}, "/season/202/alltimeslots/ limit=2&offset=2"},
+		{func() (int, int, error) {
+			page, err := s.GetUserPhotosPage(7449, 2, 2)
+			return len(page.Items), page.Total, err
+		}, "/user/7449/allphotos/ limit=2&offset=2"},
+		{func() (int, int, error) {
+			page, err := s.GetOpenCoverRequestsPage(2, 2)
+			return len(page.Items), page.Total, err
+		}, "/coverrequest/open limit=2&offset=2"},
+		{func() (int, int, error) {
+			page, err := s.GetTimeslotMessagesPage(303, 10, 2, 2)
+			return len(page.Items), page.Total, err
+		}, "/timeslot/303/messages limit=2&offset=2&since=10"},
+		{func() (int, int, error) {
+			page, err := s.GetSelectorLockHistoryPage(2, 2)
+			return len(page.Items), page.Total, err
+		}, "/selector/lockhistory limit=2&offset=2"},
+	}
+
+	for _, test := range tests {
+		items, total, err := test.fetch()
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if items != 2 || total != 5 {
+			t.Error("Got:", items, "items of", total, ", Expected: 2 of 5")
+		}
+		if got != test.expected {
+			t.Error("Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
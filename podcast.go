@@ -0,0 +1,172 @@
+package myradio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// PodcastStatus is the stage a podcast has reached in the publishing workflow.
+type PodcastStatus string
+
+const (
+	// PodcastDraft is a podcast that has been uploaded but not submitted.
+	PodcastDraft PodcastStatus = "draft"
+	// PodcastPendingApproval is a podcast waiting for approval by the production team.
+	PodcastPendingApproval PodcastStatus = "pending"
+	// PodcastApproved is a podcast that is approved, but not yet published.
+	PodcastApproved PodcastStatus = "approved"
+	// PodcastScheduled is a podcast that will be published at its publish time.
+	PodcastScheduled PodcastStatus = "scheduled"
+	// PodcastProcessing is a podcast whose audio MyRadio is still encoding.
+	PodcastProcessing PodcastStatus = "processing"
+	// PodcastPublished is a podcast that is available to the public.
+	PodcastPublished PodcastStatus = "published"
+	// PodcastFailed is a podcast whose audio MyRadio could not process.
+	PodcastFailed PodcastStatus = "failed"
+)
+
+// Podcast is a podcast episode.
+type Podcast struct {
+	PodcastID    uint          `json:"podcast_id"`
+	Title        string        `json:"title"`
+	Description  string        `json:"description"`
+	ShowID       ShowID        `json:"show_id,omitempty"`
+	Status       PodcastStatus `json:"status"`
+	SubmittedRaw string        `json:"submitted"`
+	Submitted    time.Time     `json:"-"`
+	// PublishTime is when the podcast was, or is scheduled to be, published.
+	PublishTime    time.Time `json:"-"`
+	PublishTimeRaw int64     `json:"time"`
+	FileURL        string    `json:"file"`
+}
+
+// GetPodcast gets the podcast with the given ID.
+//
+// This consumes one API request.
+func (s *Session) GetPodcast(id int) (podcast Podcast, err error) {
+	data, err := s.apiRequest(fmt.Sprintf("/podcast/%d", id), []string{})
+	if err != nil {
+		return
+	}
+	if data == nil {
+		err = errors.New("No podcast found")
+		return
+	}
+	err = json.Unmarshal(*data, &podcast)
+	if err != nil {
+		return
+	}
+	err = podcast.parseTimes()
+	return
+}
+
+// parseTimes fills in the podcast's times from their raw values.
+func (p *Podcast) parseTimes() (err error) {
+	if p.SubmittedRaw != "" {
+		p.Submitted, err = time.Parse(dateTimeLayout, p.SubmittedRaw)
+		if err != nil {
+			return
+		}
+	}
+	if p.PublishTimeRaw != 0 {
+		p.PublishTime = time.Unix(p.PublishTimeRaw, 0)
+	}
+	return
+}
+
+// GetShowPodcastsPage gets a page of up to limit podcasts belonging to the
+// show with the given ID, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetShowPodcastsPage(showid ShowID, limit, offset int) (*Page[Podcast], error) {
+	page, err := getPage[Podcast](s, fmt.Sprintf("/show/%d/allpodcasts", showid), nil, limit, offset, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k := range page.Items {
+		if err = page.Items[k].parseTimes(); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// GetShowPodcasts gets all podcasts belonging to the show with the given ID.
+//
+// This consumes one API request.
+func (s *Session) GetShowPodcasts(showid ShowID) ([]Podcast, error) {
+	page, err := s.GetShowPodcastsPage(showid, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// GetPodcastStatus gets the workflow status of the podcast with the given ID.
+//
+// This consumes one API request.
+func (s *Session) GetPodcastStatus(id int) (status PodcastStatus, err error) {
+	data, err := s.apiRequest(fmt.Sprintf("/podcast/%d/status", id), []string{})
+	if err != nil {
+		return
+	}
+	if data == nil {
+		err = errors.New("No podcast status set")
+		return
+	}
+	err = json.Unmarshal(*data, &status)
+	return
+}
+
+// SubmitPodcastForApproval submits the draft podcast with the given ID to
+// the production team for approval.
+//
+// This consumes one API request.
+func (s *Session) SubmitPodcastForApproval(id int) error {
+	_, err := s.apiRequestWithParams("POST", fmt.Sprintf("/podcast/%d/submit", id), nil, nil)
+	return err
+}
+
+// ApprovePodcast approves the pending podcast with the given ID.
+//
+// This consumes one API request.
+func (s *Session) ApprovePodcast(id int) error {
+	_, err := s.apiRequestWithParams("POST", fmt.Sprintf("/podcast/%d/approve", id), nil, nil)
+	return err
+}
+
+// PublishPodcast publishes the approved podcast with the given ID at the given time.
+//
+// If at is zero or in the past, the podcast is published immediately.
+//
+// This consumes one API request.
+func (s *Session) PublishPodcast(id int, at time.Time) error {
+	var params url.Values
+	if !at.IsZero() {
+		params = url.Values{"time": []string{strconv.FormatInt(at.Unix(), 10)}}
+	}
+	_, err := s.apiRequestWithParams("POST", fmt.Sprintf("/podcast/%d/publish", id), nil, params)
+	return err
+}
+
+// WaitForPodcastProcessing polls the status of the podcast with the given ID
+// every interval until MyRadio has finished processing its audio, then
+// returns the resulting status.
+//
+// Returns ErrPollTimeout if processing hasn't finished within timeout.
+//
+// This consumes one API request per poll.
+func (s *Session) WaitForPodcastProcessing(id int, interval, timeout time.Duration) (status PodcastStatus, err error) {
+	err = poll(interval, timeout, func() (bool, error) {
+		var perr error
+		status, perr = s.GetPodcastStatus(id)
+		return perr == nil && status != PodcastProcessing, perr
+	})
+	return
+}
@@ -0,0 +1,129 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPodcastWorkflow(t *testing.T) {
+	rec := newRequestRecorder(t)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r, "time")
+		if r.URL.Path == "/podcast/77/status" {
+			writePayload(w, PodcastApproved)
+			return
+		}
+		writePayload(w, nil)
+	}))
+
+	if err := s.SubmitPodcastForApproval(77); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ApprovePodcast(77); err != nil {
+		t.Fatal(err)
+	}
+	status, err := s.GetPodcastStatus(77)
+	if err != nil || status != PodcastApproved {
+		t.Error("Got:", status, ", Error:", err, ", Expected:", PodcastApproved)
+	}
+	if err = s.PublishPodcast(77, time.Unix(1446000000, 0)); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.PublishPodcast(77, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /podcast/77/submit ",
+		"POST /podcast/77/approve ",
+		"GET /podcast/77/status ",
+		"POST /podcast/77/publish 1446000000",
+		"POST /podcast/77/publish ",
+	}
+	if got := rec.requests(); !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+}
+
+func TestWaitForPodcastProcessing(t *testing.T) {
+	tests := []struct {
+		name string
+		// statuses are the statuses returned by successive polls, the last
+		// repeating forever.
+		statuses      []PodcastStatus
+		expected      PodcastStatus
+		expectedPolls int
+		expectedErr   error
+	}{
+		{"Published", []PodcastStatus{PodcastProcessing, PodcastProcessing, PodcastPublished}, PodcastPublished, 3, nil},
+		{"Failed", []PodcastStatus{PodcastProcessing, PodcastFailed}, PodcastFailed, 2, nil},
+		{"TimedOut", []PodcastStatus{PodcastProcessing}, PodcastProcessing, 0, ErrPollTimeout},
+	}
+
+	for _, test := range tests {
+		polls := 0
+		s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "GET" || r.URL.Path != "/podcast/77/status" {
+				t.Error("Got request:", r.Method, r.URL.Path)
+			}
+			polls++
+			if polls < len(test.statuses) {
+				writePayload(w, test.statuses[polls-1])
+			} else {
+				writePayload(w, test.statuses[len(test.statuses)-1])
+			}
+		}))
+
+		status, err := s.WaitForPodcastProcessing(77, time.Millisecond, 20*time.Millisecond)
+		if status != test.expected || err != test.expectedErr {
+			t.Error(test.name, ", Got:", status, ", Error:", err, ", Expected:", test.expected, test.expectedErr)
+		}
+		if test.expectedPolls != 0 && polls != test.expectedPolls {
+			t.Error(test.name, ", Got:", polls, "polls, Expected:", test.expectedPolls)
+		}
+		if test.expectedErr == ErrPollTimeout && polls < 2 {
+			t.Error(test.name, ", Got:", polls, "polls, Expected: polling until the timeout")
+		}
+	}
+}
+
+func TestPodcastNullPayload(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, nil)
+	}))
+
+	if _, err := s.GetPodcast(77); err == nil {
+		t.Error("Expected an error for a missing podcast")
+	}
+	if _, err := s.GetPodcastStatus(77); err == nil {
+		t.Error("Expected an error for a missing status")
+	}
+}
+
+func TestGetShowPodcasts(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/show/101/allpodcasts" {
+			t.Error("Got request for:", r.URL)
+		}
+		writePayload(w, []map[string]interface{}{
+			{"podcast_id": 77, "title": "Highlights", "status": "published", "submitted": "26/10/2015 09:00", "time": 1446000000},
+			{"podcast_id": 78, "title": "Outtakes", "status": "draft"},
+		})
+	}))
+
+	podcasts, err := s.GetShowPodcasts(101)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(podcasts) != 2 {
+		t.Fatal("Got:", podcasts)
+	}
+	if !podcasts[0].Submitted.Equal(time.Date(2015, 10, 26, 9, 0, 0, 0, time.UTC)) || !podcasts[0].PublishTime.Equal(time.Unix(1446000000, 0)) {
+		t.Error("Got times:", podcasts[0].Submitted, podcasts[0].PublishTime)
+	}
+	if !podcasts[1].Submitted.IsZero() || !podcasts[1].PublishTime.IsZero() {
+		t.Error("Got times:", podcasts[1].Submitted, podcasts[1].PublishTime, ", Expected: none")
+	}
+}
@@ -0,0 +1,73 @@
+package myradio
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+)
+
+// ErrNoPreference is the error returned when getting a user preference that isn't set.
+var ErrNoPreference = errors.New("No preference set")
+
+// GetUserPreference gets the preference with the given key of the member
+// the API key belongs to.
+//
+// Returns ErrNoPreference if the preference isn't set.
+//
+// This consumes one API request.
+func (s *Session) GetUserPreference(key string) (string, error) {
+	params := url.Values{"key": []string{key}}
+	data, err := s.apiRequestWithParams("GET", "/user/currentuser/preference", nil, params)
+	if IsNotFound(err) {
+		return "", ErrNoPreference
+	}
+	if err != nil {
+		return "", err
+	}
+	var value *string
+	if data != nil {
+		err = json.Unmarshal(*data, &value)
+		if err != nil {
+			return "", err
+		}
+	}
+	if value == nil {
+		return "", ErrNoPreference
+	}
+	return *value, nil
+}
+
+// GetUserPreferences gets all of the preferences of the member the API key
+// belongs to, as a map from key to value.
+//
+// This consumes one API request.
+func (s *Session) GetUserPreferences() (map[string]string, error) {
+	data, err := s.apiRequest("/user/currentuser/preferences", nil)
+	if err != nil {
+		return nil, err
+	}
+	prefs := make(map[string]string)
+	if data != nil {
+		err = json.Unmarshal(*data, &prefs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return prefs, nil
+}
+
+// SetUserPreference sets the preference with the given key, of the member
+// the API key belongs to, to the given value.
+//
+// This consumes one API request.
+func (s *Session) SetUserPreference(key, value string) error {
+	if key == "" {
+		return errors.New("Preference key must not be empty")
+	}
+	params := url.Values{
+		"key":   []string{key},
+		"value": []string{value},
+	}
+	_, err := s.apiRequestWithParams("PUT", "/user/currentuser/preference", nil, params)
+	return err
+}
@@ -0,0 +1,94 @@
+package myradio
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Training statuses that can be awarded to members.
+const (
+	TrainingStudioTrained = 1
+	TrainingStudioDemoed  = 2
+	TrainingTrainer       = 3
+)
+
+// GetMembersWithTraining gets all members who have been awarded the training
+// status with the given ID (see the Training constants).
+//
+// This consumes one API request.
+func (s *Session) GetMembersWithTraining(statusid int) ([]Member, error) {
+	data, err := s.apiRequest(fmt.Sprintf("/trainingstatus/%d/awardedto", statusid), []string{})
+	if err != nil || data == nil {
+		return nil, err
+	}
+	var members []Member
+	err = json.Unmarshal(*data, &members)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// GetStudioTrainedMembers gets all members who are trained to present from the studio.
+//
+// This consumes one API request.
+func (s *Session) GetStudioTrainedMembers() ([]Member, error) {
+	return s.GetMembersWithTraining(TrainingStudioTrained)
+}
+
+// GetTrainers gets all members who are allowed to train others.
+//
+// This consumes one API request.
+func (s *Session) GetTrainers() ([]Member, error) {
+	return s.GetMembersWithTraining(TrainingTrainer)
+}
+
+// GetCurrentOfficers gets all members currently holding at least one officer position.
+//
+// Each member appears only once, however many positions they hold.
+//
+// This consumes one API request.
+func (s *Session) GetCurrentOfficers() ([]Member, error) {
+	officers, err := s.GetAllOfficers()
+	if err != nil {
+		return nil, err
+	}
+	var members []Member
+	seen := make(map[UserID]bool)
+	for _, o := range officers {
+		if o.Status != "c" {
+			continue
+		}
+		for _, m := range o.Current {
+			if !seen[m.Memberid] {
+				seen[m.Memberid] = true
+				members = append(members, m)
+			}
+		}
+	}
+	return members, nil
+}
+
+// GetCurrentPresenters gets all members credited as presenters on a show
+// scheduled in the current term.
+//
+// Each member appears only once, however many shows they present.
+//
+// This consumes one API request.
+func (s *Session) GetCurrentPresenters() ([]Member, error) {
+	shows, err := s.GetAllShows(true)
+	if err != nil {
+		return nil, err
+	}
+	var members []Member
+	seen := make(map[UserID]bool)
+	for _, show := range shows {
+		for _, c := range show.Credits {
+			if c.Type == CreditTypePresenter && !seen[c.MemberID] {
+				seen[c.MemberID] = true
+				members = append(members, c.User)
+			}
+		}
+	}
+	return members, nil
+}
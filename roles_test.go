@@ -0,0 +1,32 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetMembersWithTraining(t *testing.T) {
+	var got []string
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.Path)
+		writePayload(w, []Member{{Memberid: 7449, Fname: "Jane", Sname: "Bloggs"}})
+	}))
+
+	calls := []func() ([]Member, error){
+		func() ([]Member, error) { return s.GetMembersWithTraining(TrainingStudioDemoed) },
+		s.GetStudioTrainedMembers,
+		s.GetTrainers,
+	}
+	for _, call := range calls {
+		members, err := call()
+		if err != nil || len(members) != 1 || members[0].Memberid != 7449 {
+			t.Error("Got:", members, ", Error:", err)
+		}
+	}
+
+	expected := []string{"/trainingstatus/2/awardedto", "/trainingstatus/1/awardedto", "/trainingstatus/3/awardedto"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Error("Got requests:", got, ", Expected:", expected)
+	}
+}
@@ -0,0 +1,103 @@
+package myradio
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/schedule"
+)
+
+// ScheduleEntry is the part of a scheduled timeslot recorded in a ScheduleSnapshot.
+type ScheduleEntry = schedule.ScheduleEntry
+
+// ScheduleSnapshot is the schedule as it stood at a given time.
+type ScheduleSnapshot = schedule.ScheduleSnapshot
+
+// ScheduleChangeType is the kind of change made to a scheduled timeslot.
+type ScheduleChangeType = schedule.ScheduleChangeType
+
+const (
+	// ScheduleAdded is a timeslot that was newly scheduled.
+	ScheduleAdded = schedule.ScheduleAdded
+	// ScheduleRemoved is a timeslot that was cancelled.
+	ScheduleRemoved = schedule.ScheduleRemoved
+	// ScheduleChanged is a timeslot that was moved, resized or retitled.
+	ScheduleChanged = schedule.ScheduleChanged
+)
+
+// ScheduleChange is a difference between two ScheduleSnapshots.
+type ScheduleChange = schedule.ScheduleChange
+
+// ScheduleHistory is a series of ScheduleSnapshots of the same part of the
+// schedule, for finding out how it stood in the past.
+type ScheduleHistory = schedule.ScheduleHistory
+
+// ScheduleHistoryChange is a change found in a ScheduleHistory.
+type ScheduleHistoryChange = schedule.ScheduleHistoryChange
+
+// NewScheduleSnapshot creates a ScheduleSnapshot of the given timeslots,
+// as they were scheduled at the given time.
+func NewScheduleSnapshot(taken time.Time, timeslots []Timeslot) *ScheduleSnapshot {
+	return schedule.NewScheduleSnapshot(taken, timeslots)
+}
+
+// DiffSchedules reports how the schedule changed between the from and to
+// snapshots, in order of the (new, or else old) start time of the changed
+// timeslots.
+//
+// This consumes no API requests.
+func DiffSchedules(from, to *ScheduleSnapshot) []ScheduleChange {
+	return schedule.DiffSchedules(from, to)
+}
+
+// GetWeekSchedule gets the timeslots scheduled in the given ISO week of the
+// given year, in order of start time.
+//
+// MyRadio only knows the schedule as it stands now; to see how it stood in
+// the past, keep ScheduleSnapshots in a ScheduleHistory.
+//
+// This consumes one API request.
+func (s *Session) GetWeekSchedule(year, week int) ([]Timeslot, error) {
+	params := url.Values{"year": []string{strconv.Itoa(year)}}
+	data, err := s.apiRequestWithParams("GET", fmt.Sprintf("/timeslot/weekschedule/%d", week), []string{}, params)
+	if err != nil {
+		return nil, err
+	}
+	// The schedule is keyed by day of the week.
+	var days map[string][]Timeslot
+	if data != nil {
+		err = json.Unmarshal(*data, &days)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var timeslots []Timeslot
+	for _, day := range days {
+		for _, t := range day {
+			err = parseTimeslotTimes(&t)
+			if err != nil {
+				return nil, err
+			}
+			timeslots = append(timeslots, t)
+		}
+	}
+	sort.SliceStable(timeslots, func(i, j int) bool {
+		return timeslots[i].StartTime.Before(timeslots[j].StartTime)
+	})
+	return timeslots, nil
+}
+
+// SnapshotWeekSchedule takes a ScheduleSnapshot of the given ISO week of the given year.
+//
+// This consumes one API request.
+func (s *Session) SnapshotWeekSchedule(year, week int) (*ScheduleSnapshot, error) {
+	timeslots, err := s.GetWeekSchedule(year, week)
+	if err != nil {
+		return nil, err
+	}
+	return NewScheduleSnapshot(time.Now(), timeslots), nil
+}
@@ -0,0 +1,60 @@
+package schedule
+
+import (
+	"time"
+)
+
+// ShowImageType is the kind of a piece of show artwork.
+type ShowImageType string
+
+const (
+	// ShowImagePhoto is a show's cover photo, shown alongside it in the schedule.
+	ShowImagePhoto ShowImageType = "photo"
+	// ShowImageBanner is a wide banner, shown at the top of a show's page.
+	ShowImageBanner ShowImageType = "banner"
+)
+
+// ShowImage is a piece of artwork uploaded for a show.
+type ShowImage struct {
+	ImageID      uint          `json:"imageid"`
+	Type         ShowImageType `json:"type"`
+	Url          string        `json:"url"`
+	DateAddedRaw string        `json:"date_added"`
+	DateAdded    time.Time     `json:"-"`
+	// Current is true if this is the image currently in use for its type.
+	Current bool `json:"current"`
+}
+
+// ImageURLer turns the site-relative paths of images into absolute URLs; a
+// *myradio.Session is one.
+type ImageURLer interface {
+	ImageURL(path string, size int) (string, error)
+}
+
+// GetPhotoURL gets the absolute URL of the show's cover photo, scaled to at
+// most size pixels on its largest side (or at full size, if size is 0).
+//
+// Returns an empty string if the show has no photo.
+//
+// This consumes no API requests.
+func (m *ShowMeta) GetPhotoURL(u ImageURLer, size int) (string, error) {
+	return u.ImageURL(m.Photo, size)
+}
+
+// GetPhotoURL gets the absolute URL of the show's cover photo, scaled to at
+// most size pixels on its largest side (or at full size, if size is 0).
+//
+// Returns an empty string if the show has no photo.
+//
+// This consumes no API requests.
+func (sh *Show) GetPhotoURL(u ImageURLer, size int) (string, error) {
+	return u.ImageURL(sh.Photo, size)
+}
+
+// GetURL gets the absolute URL of the image, scaled to at most size pixels
+// on its largest side (or at full size, if size is 0).
+//
+// This consumes no API requests.
+func (i *ShowImage) GetURL(u ImageURLer, size int) (string, error) {
+	return u.ImageURL(i.Url, size)
+}
@@ -0,0 +1,31 @@
+package schedule
+
+import "strconv"
+
+// ShowID is the ID of a show.
+type ShowID int
+
+// TimeslotID is the ID of a Timeslot, that is, of one episode of a show.
+type TimeslotID uint64
+
+// String returns the ID in decimal.
+func (id ShowID) String() string {
+	return strconv.Itoa(int(id))
+}
+
+// String returns the ID in decimal.
+func (id TimeslotID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// ParseShowID parses a ShowID written in decimal, as in a URL.
+func ParseShowID(s string) (ShowID, error) {
+	id, err := strconv.Atoi(s)
+	return ShowID(id), err
+}
+
+// ParseTimeslotID parses a TimeslotID written in decimal, as in a URL.
+func ParseTimeslotID(s string) (TimeslotID, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	return TimeslotID(id), err
+}
@@ -0,0 +1,58 @@
+package schedule
+
+import (
+	"encoding/json"
+
+	"github.com/UniversityRadioYork/myradio-go/internal/timefmt"
+)
+
+// The MarshalJSON methods in this file encode types back into the same form
+// the API uses, as the ones in the myradio package do.
+
+func (i ShowImage) MarshalJSON() ([]byte, error) {
+	type showImage ShowImage
+	i.DateAddedRaw = timefmt.Format(i.DateAdded, timefmt.DateTime, i.DateAddedRaw)
+	return json.Marshal(showImage(i))
+}
+
+// withRawTimes returns a copy of the Season with its raw time fields regenerated.
+func (s Season) withRawTimes() Season {
+	s.SubmittedRaw = timefmt.Format(s.Submitted, timefmt.DateTime, s.SubmittedRaw)
+	s.FirstTimeRaw = timefmt.Format(s.FirstTime, timefmt.DateTime, s.FirstTimeRaw)
+	return s
+}
+
+func (s Season) MarshalJSON() ([]byte, error) {
+	type season Season
+	return json.Marshal(season(s.withRawTimes()))
+}
+
+func (t Timeslot) MarshalJSON() ([]byte, error) {
+	type timeslot Timeslot
+	t.Season = t.Season.withRawTimes()
+	t.TimeRaw = timefmt.Unix(t.Time, t.TimeRaw)
+	t.StartTimeRaw = timefmt.Format(t.StartTime, timefmt.DateTime, t.StartTimeRaw)
+	if t.Duration != 0 {
+		t.DurationRaw = timefmt.FormatDuration(t.Duration)
+	}
+	return json.Marshal(struct {
+		timeslot
+		// This shadows the MarshalJSON promoted from Season, which would
+		// otherwise be used to marshal the whole Timeslot.
+		MarshalJSON struct{} `json:"-"`
+	}{timeslot: timeslot(t)})
+}
+
+func (t TracklistItem) MarshalJSON() ([]byte, error) {
+	type tracklistItem TracklistItem
+	t.TimeRaw = timefmt.Unix(t.Time, t.TimeRaw)
+	t.StartTimeRaw = timefmt.Format(t.StartTime, timefmt.TracklistTime, t.StartTimeRaw)
+	return json.Marshal(tracklistItem(t))
+}
+
+func (sh Show) MarshalJSON() ([]byte, error) {
+	type show Show
+	sh.StartTimeRaw = timefmt.Unix(sh.StartTime, sh.StartTimeRaw)
+	sh.EndTimeRaw = timefmt.Unix(sh.EndTime, sh.EndTimeRaw)
+	return json.Marshal(show(sh))
+}
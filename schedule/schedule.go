@@ -0,0 +1,168 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// ScheduleEntry is the part of a scheduled timeslot recorded in a ScheduleSnapshot.
+type ScheduleEntry struct {
+	TimeslotID TimeslotID    `json:"timeslot_id"`
+	ShowID     ShowID        `json:"show_id"`
+	Title      string        `json:"title"`
+	StartTime  time.Time     `json:"start_time"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// ScheduleSnapshot is the schedule as it stood at a given time.
+//
+// Snapshots encode to and from JSON, so they can be stored between runs.
+type ScheduleSnapshot struct {
+	// Taken is when the schedule was fetched.
+	Taken time.Time `json:"taken"`
+	// Entries are the scheduled timeslots, in order of start time.
+	Entries []ScheduleEntry `json:"entries"`
+}
+
+// NewScheduleSnapshot creates a ScheduleSnapshot of the given timeslots,
+// as they were scheduled at the given time.
+func NewScheduleSnapshot(taken time.Time, timeslots []Timeslot) *ScheduleSnapshot {
+	snap := &ScheduleSnapshot{Taken: taken, Entries: make([]ScheduleEntry, len(timeslots))}
+	for k, t := range timeslots {
+		snap.Entries[k] = ScheduleEntry{
+			TimeslotID: t.TimeslotID,
+			ShowID:     t.ShowID,
+			Title:      t.Title,
+			StartTime:  t.StartTime,
+			Duration:   t.Duration,
+		}
+	}
+	sort.SliceStable(snap.Entries, func(i, j int) bool {
+		return snap.Entries[i].StartTime.Before(snap.Entries[j].StartTime)
+	})
+	return snap
+}
+
+// ScheduleChangeType is the kind of change made to a scheduled timeslot.
+type ScheduleChangeType int
+
+const (
+	// ScheduleAdded is a timeslot that was newly scheduled.
+	ScheduleAdded ScheduleChangeType = iota + 1
+	// ScheduleRemoved is a timeslot that was cancelled.
+	ScheduleRemoved
+	// ScheduleChanged is a timeslot that was moved, resized or retitled.
+	ScheduleChanged
+)
+
+func (t ScheduleChangeType) String() string {
+	switch t {
+	case ScheduleAdded:
+		return "added"
+	case ScheduleRemoved:
+		return "removed"
+	case ScheduleChanged:
+		return "changed"
+	}
+	return "unknown"
+}
+
+// ScheduleChange is a difference between two ScheduleSnapshots.
+type ScheduleChange struct {
+	Type ScheduleChangeType
+	// Old is the timeslot as it was, or nil if it was added.
+	Old *ScheduleEntry
+	// New is the timeslot as it is now, or nil if it was removed.
+	New *ScheduleEntry
+}
+
+// DiffSchedules reports how the schedule changed between the from and to
+// snapshots, in order of the (new, or else old) start time of the changed
+// timeslots.
+//
+// This consumes no API requests.
+func DiffSchedules(from, to *ScheduleSnapshot) []ScheduleChange {
+	remaining := make(map[TimeslotID]*ScheduleEntry, len(from.Entries))
+	for k := range from.Entries {
+		remaining[from.Entries[k].TimeslotID] = &from.Entries[k]
+	}
+	var changes []ScheduleChange
+	for k := range to.Entries {
+		n := &to.Entries[k]
+		o, ok := remaining[n.TimeslotID]
+		switch {
+		case !ok:
+			changes = append(changes, ScheduleChange{Type: ScheduleAdded, New: n})
+		case !o.StartTime.Equal(n.StartTime) || o.Duration != n.Duration || o.Title != n.Title || o.ShowID != n.ShowID:
+			changes = append(changes, ScheduleChange{Type: ScheduleChanged, Old: o, New: n})
+		}
+		delete(remaining, n.TimeslotID)
+	}
+	for k := range from.Entries {
+		if o := &from.Entries[k]; remaining[o.TimeslotID] != nil {
+			changes = append(changes, ScheduleChange{Type: ScheduleRemoved, Old: o})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].time().Before(changes[j].time())
+	})
+	return changes
+}
+
+// time returns the time the change is ordered by.
+func (c ScheduleChange) time() time.Time {
+	if c.New != nil {
+		return c.New.StartTime
+	}
+	return c.Old.StartTime
+}
+
+// ScheduleHistory is a series of ScheduleSnapshots of the same part of the
+// schedule, for finding out how it stood in the past.
+//
+// Histories encode to and from JSON, so they can be stored between runs.
+type ScheduleHistory struct {
+	// Snapshots are in the order they were taken.
+	Snapshots []ScheduleSnapshot `json:"snapshots"`
+}
+
+// Add adds a snapshot to the history.
+func (h *ScheduleHistory) Add(snap *ScheduleSnapshot) {
+	i := sort.Search(len(h.Snapshots), func(i int) bool {
+		return h.Snapshots[i].Taken.After(snap.Taken)
+	})
+	h.Snapshots = append(h.Snapshots, ScheduleSnapshot{})
+	copy(h.Snapshots[i+1:], h.Snapshots[i:])
+	h.Snapshots[i] = *snap
+}
+
+// At returns the latest snapshot taken at or before the given time, or nil
+// if there is none.
+func (h *ScheduleHistory) At(t time.Time) *ScheduleSnapshot {
+	i := sort.Search(len(h.Snapshots), func(i int) bool {
+		return h.Snapshots[i].Taken.After(t)
+	})
+	if i == 0 {
+		return nil
+	}
+	return &h.Snapshots[i-1]
+}
+
+// Changes returns every change between consecutive snapshots in the history,
+// along with when each was first seen.
+func (h *ScheduleHistory) Changes() []ScheduleHistoryChange {
+	var changes []ScheduleHistoryChange
+	for i := 1; i < len(h.Snapshots); i++ {
+		for _, c := range DiffSchedules(&h.Snapshots[i-1], &h.Snapshots[i]) {
+			changes = append(changes, ScheduleHistoryChange{ScheduleChange: c, Seen: h.Snapshots[i].Taken})
+		}
+	}
+	return changes
+}
+
+// ScheduleHistoryChange is a change found in a ScheduleHistory.
+type ScheduleHistoryChange struct {
+	ScheduleChange
+	// Seen is when the snapshot the change first appeared in was taken.
+	Seen time.Time
+}
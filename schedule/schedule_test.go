@@ -0,0 +1,96 @@
+package schedule
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewScheduleSnapshot(t *testing.T) {
+	at := func(day int) time.Time { return time.Date(2015, 10, day, 7, 0, 0, 0, time.UTC) }
+	season := Season{ShowMeta: ShowMeta{ShowID: 101, Title: "Breakfast"}}
+	early := Timeslot{Season: season, TimeslotID: 303, StartTime: at(26), Duration: 2 * time.Hour}
+	late := Timeslot{Season: season, TimeslotID: 304, StartTime: at(27), Duration: time.Hour}
+
+	snap := NewScheduleSnapshot(at(20), []Timeslot{late, early})
+	expected := &ScheduleSnapshot{Taken: at(20), Entries: []ScheduleEntry{
+		{TimeslotID: 303, ShowID: 101, Title: "Breakfast", StartTime: at(26), Duration: 2 * time.Hour},
+		{TimeslotID: 304, ShowID: 101, Title: "Breakfast", StartTime: at(27), Duration: time.Hour},
+	}}
+	if !reflect.DeepEqual(snap, expected) {
+		t.Error("Got:", snap, ", Expected:", expected)
+	}
+}
+
+func TestDiffSchedules(t *testing.T) {
+	at := func(day int) time.Time { return time.Date(2015, 10, day, 7, 0, 0, 0, time.UTC) }
+	from := &ScheduleSnapshot{Taken: at(1), Entries: []ScheduleEntry{
+		{TimeslotID: 301, ShowID: 101, Title: "Breakfast", StartTime: at(5), Duration: time.Hour},
+		{TimeslotID: 302, ShowID: 101, Title: "Breakfast", StartTime: at(12), Duration: time.Hour},
+		{TimeslotID: 303, ShowID: 101, Title: "Breakfast", StartTime: at(19), Duration: time.Hour},
+	}}
+	to := &ScheduleSnapshot{Taken: at(2), Entries: []ScheduleEntry{
+		{TimeslotID: 301, ShowID: 101, Title: "Breakfast", StartTime: at(5), Duration: time.Hour},
+		{TimeslotID: 303, ShowID: 101, Title: "Breakfast", StartTime: at(19), Duration: 2 * time.Hour},
+		{TimeslotID: 304, ShowID: 102, Title: "Lunch", StartTime: at(20), Duration: time.Hour},
+	}}
+
+	changes := DiffSchedules(from, to)
+	expected := []ScheduleChange{
+		{Type: ScheduleRemoved, Old: &from.Entries[1]},
+		{Type: ScheduleChanged, Old: &from.Entries[2], New: &to.Entries[1]},
+		{Type: ScheduleAdded, New: &to.Entries[2]},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Error("Got:", changes, ", Expected:", expected)
+	}
+	if changes := DiffSchedules(to, to); len(changes) != 0 {
+		t.Error("Got:", changes, ", Expected: no changes")
+	}
+}
+
+func TestScheduleHistory(t *testing.T) {
+	at := func(day int) time.Time { return time.Date(2015, 10, day, 7, 0, 0, 0, time.UTC) }
+	entry := ScheduleEntry{TimeslotID: 301, ShowID: 101, Title: "Breakfast", StartTime: at(5), Duration: time.Hour}
+	moved := entry
+	moved.StartTime = at(6)
+
+	var h ScheduleHistory
+	h.Add(&ScheduleSnapshot{Taken: at(3), Entries: []ScheduleEntry{moved}})
+	h.Add(&ScheduleSnapshot{Taken: at(1), Entries: []ScheduleEntry{entry}})
+
+	// Histories should survive being stored.
+	data, err := json.Marshal(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded ScheduleHistory
+	if err = json.Unmarshal(data, &loaded); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		at       time.Time
+		expected *ScheduleEntry
+	}{
+		{at(0), nil},
+		{at(1), &entry},
+		{at(2), &entry},
+		{at(4), &moved},
+	}
+	for _, test := range tests {
+		snap := loaded.At(test.at)
+		switch {
+		case test.expected == nil && snap != nil:
+			t.Error("At:", test.at, ", Got:", snap, ", Expected: nothing")
+		case test.expected != nil && (snap == nil || !snap.Entries[0].StartTime.Equal(test.expected.StartTime)):
+			t.Error("At:", test.at, ", Got:", snap, ", Expected:", test.expected)
+		}
+	}
+
+	changes := loaded.Changes()
+	if len(changes) != 1 || changes[0].Type != ScheduleChanged || !changes[0].Seen.Equal(at(3)) {
+		t.Error("Got:", changes)
+	}
+}
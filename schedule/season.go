@@ -0,0 +1,27 @@
+package schedule
+
+import (
+	"fmt"
+)
+
+// Number returns the season's position among its show's seasons, counting from 1.
+//
+// This consumes no API requests.
+func (s *Season) Number() int {
+	return s.SeasonNum
+}
+
+// EpisodeNumber returns the timeslot's position within its season, counting from 1.
+//
+// This consumes no API requests.
+func (t *Timeslot) EpisodeNumber() int {
+	return t.TimeslotNum
+}
+
+// SeasonEpisodeString returns the season and episode numbers of the timeslot
+// in the form "S04E07".
+//
+// This consumes no API requests.
+func (t *Timeslot) SeasonEpisodeString() string {
+	return fmt.Sprintf("S%02dE%02d", t.Number(), t.EpisodeNumber())
+}
@@ -0,0 +1,23 @@
+package schedule
+
+import (
+	"testing"
+)
+
+func TestSeasonEpisodeString(t *testing.T) {
+	tests := []struct {
+		season, episode int
+		expected        string
+	}{
+		{4, 7, "S04E07"},
+		{1, 1, "S01E01"},
+		{12, 105, "S12E105"},
+	}
+
+	for _, test := range tests {
+		ts := Timeslot{Season: Season{SeasonNum: test.season}, TimeslotNum: test.episode}
+		if got := ts.SeasonEpisodeString(); got != test.expected {
+			t.Error("Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
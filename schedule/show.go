@@ -0,0 +1,100 @@
+// Package schedule holds the MyRadio types describing shows and when they
+// are on: seasons, timeslots, show artwork and snapshots of the schedule.
+//
+// Most programs should use the myradio package, which aliases these types
+// and has the Session methods for fetching them.
+package schedule
+
+import (
+	"sort"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/users"
+)
+
+// CreditTypePresenter is the Credit type of a show's presenters.
+const CreditTypePresenter = 1
+
+type Credit struct {
+	Type     int          `json:"type"`
+	MemberID users.UserID `json:"memberid"`
+	User     users.Member `json:"User"`
+}
+
+// @TODO: Refactor this to something better named
+type ShowMeta struct {
+	ShowID        ShowID   `json:"show_id"`
+	Slug          string   `json:"slug,omitempty"`
+	Title         string   `json:"title"`
+	CreditsString string   `json:"credits_string"`
+	Credits       []Credit `json:"credits"`
+	Description   string   `json:"description"`
+	ShowTypeID    int      `json:"show_type_id"`
+	Season        Link     `json:"seasons"`
+	EditLink      Link     `json:"editlink"`
+	ApplyLink     Link     `json:"applylink"`
+	MicroSiteLink Link     `json:"micrositelink"`
+	Photo         string   `json:"photo"`
+}
+
+type Link struct {
+	Display string      `json:"display"`
+	Value   interface{} `json:"value"`
+	Title   string      `json:"title,omitempty"`
+	URL     string      `json:"url"`
+}
+
+type Season struct {
+	ShowMeta
+	SeasonID      int       `json:"season_id"`
+	SeasonNum     int       `json:"season_num"`
+	SubmittedRaw  string    `json:"submitted"`
+	Submitted     time.Time `json:"-"`
+	RequestedTime string    `json:"requested_time"`
+	FirstTimeRaw  string    `json:"first_time"`
+	FirstTime     time.Time `json:"-"`
+	NumEpisodes   Link      `json:"num_episodes"`
+	AllocateLink  Link      `json:"allocatelink"`
+	RejectLink    Link      `json:"rejectlink"`
+}
+
+// TimeslotGetter looks up the seasons of shows and their timeslots; a
+// *myradio.Session is one.
+type TimeslotGetter interface {
+	GetSeasons(id ShowID) ([]Season, error)
+	GetTimeslotsForSeason(id int) ([]Timeslot, error)
+}
+
+// GetAllTimeslots gets all of the show's timeslots, across all of its seasons,
+// that start between from (inclusive) and to (exclusive), in order of start time.
+//
+// A zero from or to leaves that end of the range open.
+//
+// This consumes one API request, plus one for each season that may have a
+// timeslot in the range.
+func (m *ShowMeta) GetAllTimeslots(g TimeslotGetter, from, to time.Time) ([]Timeslot, error) {
+	seasons, err := g.GetSeasons(m.ShowID)
+	if err != nil {
+		return nil, err
+	}
+	var timeslots []Timeslot
+	for _, season := range seasons {
+		// No timeslot of a season starts before its first one.
+		if !to.IsZero() && !season.FirstTime.Before(to) {
+			continue
+		}
+		seasonTimeslots, err := g.GetTimeslotsForSeason(season.SeasonID)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range seasonTimeslots {
+			if (from.IsZero() || !t.StartTime.Before(from)) && (to.IsZero() || t.StartTime.Before(to)) {
+				timeslots = append(timeslots, t)
+			}
+		}
+	}
+	sort.SliceStable(timeslots, func(i, j int) bool {
+		return timeslots[i].StartTime.Before(timeslots[j].StartTime)
+	})
+	return timeslots, nil
+}
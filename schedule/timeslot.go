@@ -0,0 +1,51 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/tracks"
+)
+
+type CurrentAndNext struct {
+	Next    Show `json:"next"`
+	Current Show `json:"current"`
+}
+
+type Show struct {
+	Title        string    `json:"title"`
+	Desc         string    `json:"desc"`
+	Photo        string    `json:"photo"`
+	StartTimeRaw int64     `json:"start_time"`
+	StartTime    time.Time `json:"-"`
+	EndTimeRaw   int64     `json:"end_time"`
+	EndTime      time.Time `json:"-"`
+	Presenters   string    `json:"presenters,omitempty"`
+	Url          string    `json:"url,omitempty"`
+	Id           uint64    `json:"id,omitempty"`
+}
+
+type Timeslot struct {
+	Season
+	TimeslotID     TimeslotID    `json:"timeslot_id"`
+	TimeslotNum    int           `json:"timeslot_num"`
+	Tags           []string      `json:"tags"`
+	Time           time.Time     `json:"-"`
+	TimeRaw        int64         `json:"time"`
+	StartTime      time.Time     `json:"-"`
+	StartTimeRaw   string        `json:"start_time"`
+	Duration       time.Duration `json:"-"`
+	DurationRaw    string        `json:"duration"`
+	MixcloudStatus string        `json:"mixcloud_status"`
+}
+
+type TracklistItem struct {
+	tracks.Track
+	Album        tracks.Album `json:"album"`
+	EditLink     Link         `json:"editlink"`
+	DeleteLink   Link         `json:"deletelink"`
+	Time         time.Time    `json:"-"`
+	TimeRaw      int64        `json:"time"`
+	StartTime    time.Time    `json:"-"`
+	StartTimeRaw string       `json:"starttime"`
+	AudioLogID   uint         `json:"audiologid"`
+}
@@ -0,0 +1,50 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetWeekSchedule(t *testing.T) {
+	monday, tuesday := testTimeslot, testTimeslot
+	tuesday.TimeslotID, tuesday.StartTime = 304, testTimeslot.StartTime.Add(24*time.Hour)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/timeslot/weekschedule/44" || r.FormValue("year") != "2015" {
+			t.Error("Got request for:", r.URL)
+		}
+		writePayload(w, map[string][]Timeslot{"2": {tuesday}, "1": {monday}})
+	}))
+
+	timeslots, err := s.GetWeekSchedule(2015, 44)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(timeslots) != 2 || !reflect.DeepEqual(timeslots[0], testTimeslot) || timeslots[1].TimeslotID != 304 {
+		t.Error("Got:", timeslots)
+	}
+}
+
+func TestSnapshotWeekSchedule(t *testing.T) {
+	tuesday := testTimeslot
+	tuesday.TimeslotID, tuesday.StartTime = 304, testTimeslot.StartTime.Add(24*time.Hour)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/timeslot/weekschedule/44" || r.FormValue("year") != "2015" {
+			t.Error("Got request for:", r.URL)
+		}
+		writePayload(w, map[string][]Timeslot{"2": {tuesday}, "1": {testTimeslot}})
+	}))
+
+	before := time.Now()
+	snap, err := s.SnapshotWeekSchedule(2015, 44)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snap.Taken.Before(before) || snap.Taken.After(time.Now()) {
+		t.Error("Got taken time:", snap.Taken, ", Expected: now")
+	}
+	if len(snap.Entries) != 2 || snap.Entries[0].TimeslotID != 303 || snap.Entries[1].TimeslotID != 304 {
+		t.Error("Got:", snap.Entries)
+	}
+}
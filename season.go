@@ -15,41 +15,37 @@ func (s *Session) GetSeason(id int) (season Season, err error) {
 	if err != nil {
 		return
 	}
-	season.FirstTime, err = time.Parse("02/01/2006 15:04", season.FirstTimeRaw)
+	season.FirstTime, err = time.Parse(dateTimeLayout, season.FirstTimeRaw)
 	if err != nil {
 		return
 	}
-	season.Submitted, err = time.Parse("02/01/2006 15:04", season.SubmittedRaw)
+	season.Submitted, err = time.Parse(dateTimeLayout, season.SubmittedRaw)
 	return
 }
 
-func (s *Session) GetTimeslotsForSeason(id int) (timeslots []Timeslot, err error) {
-	data, err := s.apiRequest(fmt.Sprintf("/season/%d/alltimeslots/", id), []string{})
+// GetTimeslotsForSeasonPage gets a page of up to limit timeslots of the
+// season with the given ID, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetTimeslotsForSeasonPage(id int, limit, offset int) (*Page[Timeslot], error) {
+	page, err := getPage[Timeslot](s, fmt.Sprintf("/season/%d/alltimeslots/", id), []string{}, limit, offset, nil)
 	if err != nil {
 		return nil, err
 	}
-	err = json.Unmarshal(*data, &timeslots)
+	for k := range page.Items {
+		if err = parseTimeslotTimes(&page.Items[k]); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+func (s *Session) GetTimeslotsForSeason(id int) ([]Timeslot, error) {
+	page, err := s.GetTimeslotsForSeasonPage(id, 0, 0)
 	if err != nil {
 		return nil, err
 	}
-	for k, v := range timeslots {
-		timeslots[k].Time = time.Unix(v.TimeRaw, 0)
-		timeslots[k].FirstTime, err = time.Parse("02/01/2006 15:04", v.FirstTimeRaw)
-		if err != nil {
-			return
-		}
-		timeslots[k].Submitted, err = time.Parse("02/01/2006 15:04", v.SubmittedRaw)
-		if err != nil {
-			return
-		}
-		timeslots[k].StartTime, err = time.Parse("02/01/2006 15:04", v.StartTimeRaw)
-		if err != nil {
-			return
-		}
-		timeslots[k].Duration, err = parseDuration("15:04:05", v.DurationRaw)
-		if err != nil {
-			return
-		}
-	}
-	return
+	return page.Items, nil
 }
@@ -0,0 +1,65 @@
+package myradio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/tracks"
+)
+
+// TrackSegue contains hints for automatically segueing out of a track.
+type TrackSegue = tracks.TrackSegue
+
+// secondsToDuration converts a (possibly fractional) number of seconds to a time.Duration.
+func secondsToDuration(secs float64) time.Duration {
+	return time.Duration(secs * float64(time.Second))
+}
+
+// formatSeconds formats a time.Duration as a number of seconds, for sending to the API.
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+}
+
+// GetTrackSegue tries to get the segue hints of the track with the given ID.
+//
+// Returns an error if the track has no segue hints set.
+//
+// This consumes one API request.
+func (s *Session) GetTrackSegue(trackid TrackID) (*TrackSegue, error) {
+	data, err := s.apiRequest(fmt.Sprintf("/track/%d/segue", trackid), nil)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("No segue set")
+	}
+	segue := new(TrackSegue)
+	err = json.Unmarshal(*data, segue)
+	if err != nil {
+		return nil, err
+	}
+	segue.FadeOut = secondsToDuration(segue.FadeOutRaw)
+	segue.Overlap = secondsToDuration(segue.OverlapRaw)
+	return segue, nil
+}
+
+// SetTrackSegue sets the segue hints of the track with the given ID.
+//
+// Only the FadeOut and Overlap fields of segue are used.
+//
+// This consumes one API request.
+func (s *Session) SetTrackSegue(trackid TrackID, segue TrackSegue) error {
+	if segue.FadeOut < 0 || segue.Overlap < 0 {
+		return errors.New("Segue points cannot be negative")
+	}
+	params := url.Values{
+		"fade_out": []string{formatSeconds(segue.FadeOut)},
+		"overlap":  []string{formatSeconds(segue.Overlap)},
+	}
+	_, err := s.apiRequestWithParams("PUT", fmt.Sprintf("/track/%d/segue", trackid), nil, params)
+	return err
+}
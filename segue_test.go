@@ -0,0 +1,52 @@
+package myradio
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestFormatSeconds(t *testing.T) {
+	tests := []struct {
+		d        time.Duration
+		expected string
+	}{
+		{0, "0"},
+		{90 * time.Second, "90"},
+		{1500 * time.Millisecond, "1.5"},
+		{time.Second / 3, "0.333333333"},
+		{time.Nanosecond, "0.000000001"},
+		{2*time.Hour + 250*time.Millisecond, "7200.25"},
+	}
+
+	for _, test := range tests {
+		if got := formatSeconds(test.d); got != test.expected {
+			t.Error("Duration:", test.d, ", Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
+
+func TestSetTrackSegue(t *testing.T) {
+	requests := 0
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != "PUT" || r.URL.Path != "/track/12345/segue" {
+			t.Error("Got:", r.Method, r.URL.Path)
+		}
+		if fade, overlap := r.FormValue("fade_out"), r.FormValue("overlap"); fade != "181.5" || overlap != "2.25" {
+			t.Error("Got fade_out:", fade, ", overlap:", overlap, ", Expected: 181.5 and 2.25")
+		}
+		writePayload(w, nil)
+	}))
+
+	segue := TrackSegue{FadeOut: 181500 * time.Millisecond, Overlap: 2250 * time.Millisecond}
+	if err := s.SetTrackSegue(12345, segue); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetTrackSegue(12345, TrackSegue{Overlap: -time.Second}); err == nil {
+		t.Error("Expected an error for a negative overlap")
+	}
+	if requests != 1 {
+		t.Error("Got:", requests, "requests, Expected: 1")
+	}
+}
@@ -0,0 +1,72 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSetSelectorLock(t *testing.T) {
+	rec := newRequestRecorder(t)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r, "reason")
+		writePayload(w, nil)
+	}))
+
+	if err := s.LockSelector("Transmitter maintenance"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UnlockSelector("Maintenance finished"); err != nil {
+		t.Fatal(err)
+	}
+	for _, set := range []func(string) error{s.LockSelector, s.UnlockSelector} {
+		if err := set(""); err != ErrNoLockReason {
+			t.Error("Got:", err, ", Expected:", ErrNoLockReason)
+		}
+	}
+
+	// The reason goes in the request body, and requests without one aren't sent.
+	expected := []string{
+		"POST /selector/lock Transmitter maintenance",
+		"POST /selector/unlock Maintenance finished",
+	}
+	if got := rec.requests(); !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+}
+
+func TestGetSelectorStatusNullPayload(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, nil)
+	}))
+	if _, err := s.GetSelectorStatus(); err == nil {
+		t.Error("Expected an error for a missing status")
+	}
+}
+
+func TestGetSelectorLockHistoryLimit(t *testing.T) {
+	var got string
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		query.Del("api_key")
+		got = query.Encode()
+		writePayload(w, []SelectorLockEvent{})
+	}))
+
+	tests := []struct {
+		limit    int
+		expected string
+	}{
+		{5, "limit=5"},
+		{0, ""},
+		{-1, ""},
+	}
+	for _, test := range tests {
+		if _, err := s.GetSelectorLockHistory(test.limit); err != nil {
+			t.Error(err)
+		}
+		if got != test.expected {
+			t.Error("Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
@@ -2,54 +2,34 @@ package myradio
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/schedule"
 )
 
-type Credit struct {
-	Type     int    `json:"type"`
-	MemberID int    `json:"memberid"`
-	User     Member `json:"User"`
-}
+// CreditTypePresenter is the Credit type of a show's presenters.
+const CreditTypePresenter = schedule.CreditTypePresenter
 
-// @TODO: Refactor this to something better named
-type ShowMeta struct {
-	ShowID        int      `json:"show_id"`
-	Title         string   `json:"title"`
-	CreditsString string   `json:"credits_string"`
-	Credits       []Credit `json:"credits"`
-	Description   string   `json:"description"`
-	ShowTypeID    int      `json:"show_type_id"`
-	Season        Link     `json:"seasons"`
-	EditLink      Link     `json:"editlink"`
-	ApplyLink     Link     `json:"applylink"`
-	MicroSiteLink Link     `json:"micrositelink"`
-	Photo         string   `json:"photo"`
-}
+// Credit is a member's credit on a show.
+type Credit = schedule.Credit
 
-type Link struct {
-	Display string      `json:"display"`
-	Value   interface{} `json:"value"`
-	Title   string      `json:"title,omitempty"`
-	URL     string      `json:"url"`
-}
+// ShowMeta is a show.
+type ShowMeta = schedule.ShowMeta
 
-type Season struct {
-	ShowMeta
-	SeasonID      int    `json:"season_id"`
-	SeasonNum     int    `json:"season_num"`
-	SubmittedRaw  string `json:"submitted"`
-	Submitted     time.Time
-	RequestedTime string `json:"requested_time"`
-	FirstTimeRaw  string `json:"first_time"`
-	FirstTime     time.Time
-	NumEpisodes   Link `json:"num_episodes"`
-	AllocateLink  Link `json:"allocatelink"`
-	RejectLink    Link `json:"rejectlink"`
-}
+// Link is a link MyRadio gives alongside a show, season or timeslot.
+type Link = schedule.Link
 
-func (s *Session) GetSearchMeta(term string) ([]ShowMeta, error) {
+// Season is a season of a show.
+type Season = schedule.Season
+
+// SearchShows gets the shows whose metadata matches the given search term.
+//
+// This consumes one API request.
+func (s *Session) SearchShows(term string) ([]ShowMeta, error) {
 
 	q := url.QueryEscape(term)
 
@@ -71,7 +51,7 @@ func (s *Session) GetSearchMeta(term string) ([]ShowMeta, error) {
 
 }
 
-func (s *Session) GetShow(id int) (*ShowMeta, error) {
+func (s *Session) GetShow(id ShowID) (*ShowMeta, error) {
 
 	data, err := s.apiRequest(fmt.Sprintf("/show/%d", id), []string{})
 
@@ -91,24 +71,106 @@ func (s *Session) GetShow(id int) (*ShowMeta, error) {
 
 }
 
-func (s *Session) GetSeasons(id int) (seasons []Season, err error) {
-	data, err := s.apiRequest(fmt.Sprintf("/show/%d/allseasons", id), []string{})
+// GetAllShowsPage gets a page of up to limit shows, or only those scheduled
+// in the current term, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetAllShowsPage(currentTermOnly bool, limit, offset int) (*Page[ShowMeta], error) {
+	params := url.Values{}
+	if currentTermOnly {
+		params.Set("current_term_only", "1")
+	}
+	return getPage[ShowMeta](s, "/show/allshows", []string{"credits"}, limit, offset, params)
+}
+
+// GetAllShows gets all shows, or only those scheduled in the current term.
+//
+// This consumes one API request.
+func (s *Session) GetAllShows(currentTermOnly bool) ([]ShowMeta, error) {
+	page, err := s.GetAllShowsPage(currentTermOnly, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// GetShowBySlug gets the show with the given URL slug (for example, "ury-breakfast").
+//
+// Returns an error if there is no show with the slug.
+//
+// This consumes one API request.
+func (s *Session) GetShowBySlug(slug string) (*ShowMeta, error) {
+	data, err := s.apiRequest(fmt.Sprintf("/show/byslug/%s", url.PathEscape(slug)), []string{})
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("No show found")
+	}
+	var show ShowMeta
+	err = json.Unmarshal(*data, &show)
 	if err != nil {
-		return
+		return nil, err
 	}
-	err = json.Unmarshal(*data, &seasons)
+	return &show, nil
+}
+
+// GetSeasonsPage gets a page of up to limit seasons of the show with the
+// given ID, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetSeasonsPage(id ShowID, limit, offset int) (*Page[Season], error) {
+	page, err := getPage[Season](s, fmt.Sprintf("/show/%d/allseasons", id), []string{}, limit, offset, nil)
 	if err != nil {
-		return
+		return nil, err
 	}
-	for k, v := range seasons {
-		seasons[k].FirstTime, err = time.Parse("02/01/2006 15:04", v.FirstTimeRaw)
+	for k, v := range page.Items {
+		page.Items[k].FirstTime, err = time.Parse(dateTimeLayout, v.FirstTimeRaw)
 		if err != nil {
-			return
+			return nil, err
 		}
-		seasons[k].Submitted, err = time.Parse("02/01/2006 15:04", v.SubmittedRaw)
+		page.Items[k].Submitted, err = time.Parse(dateTimeLayout, v.SubmittedRaw)
 		if err != nil {
-			return
+			return nil, err
 		}
 	}
-	return
+	return page, nil
+}
+
+func (s *Session) GetSeasons(id ShowID) ([]Season, error) {
+	page, err := s.GetSeasonsPage(id, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// AddShowCredit credits the member with the given ID on the show with the
+// given ID, with the given credit type (for example, CreditTypePresenter).
+//
+// This consumes one API request.
+func (s *Session) AddShowCredit(showid ShowID, memberid UserID, creditType int) error {
+	params := url.Values{
+		"memberid":    []string{memberid.String()},
+		"credit_type": []string{strconv.Itoa(creditType)},
+	}
+	_, err := s.apiRequestWithParams("POST", fmt.Sprintf("/show/%d/credit", showid), nil, params)
+	return err
+}
+
+// RemoveShowCredit removes the credit of the given type for the member with
+// the given ID from the show with the given ID.
+//
+// This consumes one API request.
+func (s *Session) RemoveShowCredit(showid ShowID, memberid UserID, creditType int) error {
+	params := url.Values{
+		"memberid":    []string{memberid.String()},
+		"credit_type": []string{strconv.Itoa(creditType)},
+	}
+	_, err := s.apiRequestWithParams("DELETE", fmt.Sprintf("/show/%d/credit", showid), nil, params)
+	return err
 }
@@ -0,0 +1,67 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetAllTimeslots(t *testing.T) {
+	autumn, spring := testSeason, testSeason
+	spring.SeasonID, spring.FirstTime = 203, time.Date(2016, 1, 11, 7, 0, 0, 0, time.UTC)
+
+	timeslotAt := func(id TimeslotID, start time.Time) Timeslot {
+		ts := testTimeslot
+		ts.TimeslotID, ts.StartTime = id, start
+		return ts
+	}
+	requests := map[string]int{}
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/show/101/allseasons":
+			writePayload(w, []Season{autumn, spring})
+		case "/season/202/alltimeslots/":
+			writePayload(w, []Timeslot{
+				timeslotAt(303, time.Date(2015, 10, 26, 7, 0, 0, 0, time.UTC)),
+				timeslotAt(301, time.Date(2015, 10, 5, 7, 0, 0, 0, time.UTC)),
+				timeslotAt(302, time.Date(2015, 10, 12, 7, 0, 0, 0, time.UTC)),
+			})
+		default:
+			t.Error("Got request for:", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	show := autumn.ShowMeta
+	timeslots, err := show.GetAllTimeslots(s, time.Date(2015, 10, 12, 7, 0, 0, 0, time.UTC), time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(timeslots) != 2 || timeslots[0].TimeslotID != 302 || timeslots[1].TimeslotID != 303 {
+		t.Error("Got:", timeslots)
+	}
+	if requests["/season/202/alltimeslots/"] != 1 {
+		t.Error("Got:", requests, ", Expected: one request for each season in range")
+	}
+}
+
+func TestShowCredits(t *testing.T) {
+	rec := newRequestRecorder(t)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r, "memberid", "credit_type")
+		writePayload(w, nil)
+	}))
+
+	if err := s.AddShowCredit(101, 7449, CreditTypePresenter); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RemoveShowCredit(101, 1234, CreditTypePresenter); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"POST /show/101/credit 7449 1", "DELETE /show/101/credit 1234 1"}
+	if got := rec.requests(); !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+}
@@ -0,0 +1,99 @@
+package myradio
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SilenceAlarm is a period of silence (dead air) detected on the output of
+// the studio selector.
+type SilenceAlarm struct {
+	AlarmID uint64 `json:"alarmid"`
+	// Studio is the number of the studio that was on air when the silence started.
+	Studio       int       `json:"studio"`
+	StartTime    time.Time `json:"-"`
+	StartTimeRaw int64     `json:"start_time"`
+	// EndTime is when sound returned, or zero if the silence is ongoing.
+	EndTime    time.Time `json:"-"`
+	EndTimeRaw int64     `json:"end_time,omitempty"`
+	// AcknowledgedBy is the member who acknowledged the alarm, if anyone has.
+	AcknowledgedBy *Member `json:"acknowledged_by,omitempty"`
+	// Note is the note left by whoever acknowledged the alarm, if any.
+	Note string `json:"note,omitempty"`
+}
+
+// Ongoing returns true if the silence hasn't yet ended.
+//
+// This consumes no API requests.
+func (a *SilenceAlarm) Ongoing() bool {
+	return a.EndTime.IsZero()
+}
+
+// Acknowledged returns true if someone has acknowledged the alarm.
+//
+// This consumes no API requests.
+func (a *SilenceAlarm) Acknowledged() bool {
+	return a.AcknowledgedBy != nil
+}
+
+// parseTimes fills in the parsed times of the SilenceAlarm.
+func (a *SilenceAlarm) parseTimes() {
+	a.StartTime = time.Unix(a.StartTimeRaw, 0)
+	if a.EndTimeRaw != 0 {
+		a.EndTime = time.Unix(a.EndTimeRaw, 0)
+	}
+}
+
+// getSilenceAlarm gets the silence alarm returned by a request to the given endpoint.
+func (s *Session) getSilenceAlarm(method, endpoint string, params url.Values) (*SilenceAlarm, error) {
+	data, err := s.apiRequestWithParams(method, endpoint, []string{}, params)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	var alarm *SilenceAlarm
+	err = json.Unmarshal(*data, &alarm)
+	if err != nil || alarm == nil {
+		return nil, err
+	}
+	alarm.parseTimes()
+	return alarm, nil
+}
+
+// GetSilenceStatus gets the current silence alarm, or nil if there is no
+// ongoing or unacknowledged silence.
+//
+// This consumes one API request.
+func (s *Session) GetSilenceStatus() (*SilenceAlarm, error) {
+	return s.getSilenceAlarm("GET", "/selector/silence", nil)
+}
+
+// RaiseSilenceAlarm reports that silence has been detected on air, while the
+// given studio was selected, returning the new alarm.
+//
+// This consumes one API request.
+func (s *Session) RaiseSilenceAlarm(studio int) (*SilenceAlarm, error) {
+	params := url.Values{"studio": []string{strconv.Itoa(studio)}}
+	return s.getSilenceAlarm("POST", "/selector/silence", params)
+}
+
+// EndSilenceAlarm reports that sound has returned after the silence alarm
+// with the given ID.
+//
+// This consumes one API request.
+func (s *Session) EndSilenceAlarm(alarmid uint64) error {
+	_, err := s.apiRequestWithParams("POST", fmt.Sprintf("/selector/silence/%d/end", alarmid), nil, nil)
+	return err
+}
+
+// AcknowledgeSilenceAlarm acknowledges the silence alarm with the given ID,
+// as the member the API key belongs to, leaving the given (optional) note.
+//
+// This consumes one API request.
+func (s *Session) AcknowledgeSilenceAlarm(alarmid uint64, note string) error {
+	params := url.Values{"note": []string{note}}
+	_, err := s.apiRequestWithParams("POST", fmt.Sprintf("/selector/silence/%d/acknowledge", alarmid), nil, params)
+	return err
+}
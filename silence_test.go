@@ -0,0 +1,43 @@
+package myradio
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSilenceAlarmWrites(t *testing.T) {
+	rec := newRequestRecorder(t)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r)
+		if r.URL.Path == "/selector/silence" {
+			writePayload(w, SilenceAlarm{AlarmID: 78, Studio: 1, StartTimeRaw: 1445860800})
+			return
+		}
+		writePayload(w, nil)
+	}))
+
+	alarm, err := s.RaiseSilenceAlarm(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alarm.AlarmID != 78 || !alarm.StartTime.Equal(time.Unix(1445860800, 0)) || !alarm.Ongoing() {
+		t.Errorf("Got: %+v", alarm)
+	}
+	if err = s.EndSilenceAlarm(78); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.AcknowledgeSilenceAlarm(78, "Presenter fell asleep"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /selector/silence studio=1",
+		"POST /selector/silence/78/end ",
+		"POST /selector/silence/78/acknowledge note=Presenter+fell+asleep",
+	}
+	if got := rec.requests(); !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+}
@@ -0,0 +1,131 @@
+package myradio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// TimeslotMessage is a message sent to the studio during a timeslot, through
+// the studio information service (SIS).
+type TimeslotMessage struct {
+	ID uint64 `json:"id"`
+	// Type is where the message came from, for example 'website' or 'sms'.
+	Type string `json:"type"`
+	// Sender is who sent the message, if known.
+	Sender string `json:"sender"`
+	Body   string `json:"body"`
+	// Location is where the sender is, if known.
+	Location string    `json:"location,omitempty"`
+	Read     bool      `json:"read"`
+	Time     time.Time `json:"-"`
+	TimeRaw  int64     `json:"time"`
+}
+
+// GetTimeslotMessagesPage gets a page of up to limit messages sent during
+// the timeslot with the given ID, oldest first, whose ID is greater than
+// since, skipping the first offset.
+//
+// Pass 0 as since to get every message. A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetTimeslotMessagesPage(timeslotid TimeslotID, since uint64, limit, offset int) (*Page[TimeslotMessage], error) {
+	params := url.Values{"since": []string{strconv.FormatUint(since, 10)}}
+	page, err := getPage[TimeslotMessage](s, fmt.Sprintf("/timeslot/%d/messages", timeslotid), []string{}, limit, offset, params)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range page.Items {
+		page.Items[k].Time = time.Unix(v.TimeRaw, 0)
+	}
+	return page, nil
+}
+
+// GetTimeslotMessages gets the messages sent during the timeslot with the
+// given ID, oldest first, whose ID is greater than since.
+//
+// Pass 0 as since to get every message.
+//
+// This consumes one API request.
+func (s *Session) GetTimeslotMessages(timeslotid TimeslotID, since uint64) ([]TimeslotMessage, error) {
+	page, err := s.GetTimeslotMessagesPage(timeslotid, since, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// SendMessage sends a message to the studio during the timeslot with the given ID.
+//
+// msgType says where the message came from (for example, 'website' or
+// 'twitter'), and sender who it is from.
+//
+// This consumes one API request.
+func (s *Session) SendMessage(timeslotid TimeslotID, msgType, sender, body string) error {
+	params := url.Values{
+		"type":   []string{msgType},
+		"sender": []string{sender},
+		"body":   []string{body},
+	}
+	_, err := s.apiRequestWithParams("POST", fmt.Sprintf("/timeslot/%d/sendmessage", timeslotid), nil, params)
+	return err
+}
+
+// MarkMessageRead marks the message with the given ID as read in the studio.
+//
+// This consumes one API request.
+func (s *Session) MarkMessageRead(id uint64) error {
+	_, err := s.apiRequestWithParams("PUT", fmt.Sprintf("/timeslotMessage/%d/read", id), nil, nil)
+	return err
+}
+
+// WatchTimeslotMessages polls for new messages sent during the timeslot with
+// the given ID every interval, and sends each one on the returned channel.
+//
+// Messages already sent before the call are included.
+// An interval of zero or less polls every second.
+// Polling carries on after an error, which is sent on the error channel if
+// there is room; the channel holds one error, and any more are dropped until
+// it is read.
+// Both channels are closed once ctx is done.
+//
+// This consumes one API request per poll.
+func (s *Session) WatchTimeslotMessages(ctx context.Context, timeslotid TimeslotID, interval time.Duration) (<-chan TimeslotMessage, <-chan error) {
+	messages := make(chan TimeslotMessage)
+	errs := make(chan error, 1)
+	interval = watchInterval(interval)
+	go func() {
+		defer close(messages)
+		defer close(errs)
+		var since uint64
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			batch, err := s.GetTimeslotMessages(timeslotid, since)
+			if err != nil {
+				select {
+				case errs <- err:
+				default:
+				}
+			}
+			for _, m := range batch {
+				select {
+				case messages <- m:
+				case <-ctx.Done():
+					return
+				}
+				if m.ID > since {
+					since = m.ID
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return messages, errs
+}
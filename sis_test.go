@@ -0,0 +1,99 @@
+package myradio
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWatchTimeslotMessages(t *testing.T) {
+	all := []map[string]interface{}{
+		{"id": 1, "type": "website", "sender": "Jane", "body": "Play Wonderwall", "time": 1445842900},
+		{"id": 2, "type": "sms", "sender": "Joe", "body": "Hello from Vanbrugh", "time": 1445843000},
+		{"id": 3, "type": "website", "body": "Good morning!", "time": 1445843100},
+	}
+	rec := newRequestRecorder(t)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Messages trickle in, one more each poll.
+		polls := rec.record(r, "since")
+		since, _ := strconv.Atoi(r.FormValue("since"))
+		messages := []map[string]interface{}{}
+		for i := since; i < polls && i < len(all); i++ {
+			messages = append(messages, all[i])
+		}
+		writePayload(w, messages)
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	messages, errs := s.WatchTimeslotMessages(ctx, 303, time.Millisecond)
+
+	for i := uint64(1); i <= 3; i++ {
+		select {
+		case m := <-messages:
+			if m.ID != i {
+				t.Error("Got message:", m.ID, ", Expected:", i)
+			}
+			if !m.Time.Equal(time.Unix(m.TimeRaw, 0)) {
+				t.Error("Got time:", m.Time)
+			}
+		case err := <-errs:
+			t.Fatal(err)
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for message", i)
+		}
+	}
+
+	cancel()
+	for range messages {
+	}
+}
+
+func TestWatchTimeslotMessagesZeroInterval(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, []map[string]interface{}{{"id": 1, "body": "Hello", "time": 1445842900}})
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// This must not panic, as a ticker would with a zero interval.
+	messages, _ := s.WatchTimeslotMessages(ctx, 303, 0)
+	select {
+	case m := <-messages:
+		if m.ID != 1 {
+			t.Error("Got message:", m.ID, ", Expected: 1")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for a message")
+	}
+
+	cancel()
+	for range messages {
+	}
+}
+
+func TestMessageWrites(t *testing.T) {
+	rec := newRequestRecorder(t)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r)
+		writePayload(w, nil)
+	}))
+
+	if err := s.SendMessage(303, "website", "Jo", "Play some Bowie!"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.MarkMessageRead(9001); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /timeslot/303/sendmessage body=Play+some+Bowie%21&sender=Jo&type=website",
+		"PUT /timeslotMessage/9001/read ",
+	}
+	if got := rec.requests(); !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+}
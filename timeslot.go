@@ -4,59 +4,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/schedule"
 )
 
-type CurrentAndNext struct {
-	Next    Show `json:"next"`
-	Current Show `json:"current"`
-}
+// CurrentAndNext is the show on air now, and the one on after it.
+type CurrentAndNext = schedule.CurrentAndNext
 
-type Show struct {
-	Title        string `json:"title"`
-	Desc         string `json:"desc"`
-	Photo        string `json:"photo"`
-	StartTimeRaw int64  `json:"start_time"`
-	StartTime    time.Time
-	EndTimeRaw   int64 `json:"end_time"`
-	EndTime      time.Time
-	Presenters   string `json:"presenters,omitempty"`
-	Url          string `json:"url,omitempty"`
-	Id           uint64 `json:"id,omitempty"`
-}
+// Show is a show as it appears in CurrentAndNext.
+type Show = schedule.Show
 
-type Timeslot struct {
-	Season
-	TimeslotID     uint64   `json:"timeslot_id"`
-	TimeslotNum    int      `json:"timeslot_num"`
-	Tags           []string `json:"tags"`
-	Time           time.Time
-	TimeRaw        int64 `json:"time"`
-	StartTime      time.Time
-	StartTimeRaw   string `json:"start_time"`
-	Duration       time.Duration
-	DurationRaw    string `json:"duration"`
-	MixcloudStatus string `json:"mixcloud_status"`
-}
+// Timeslot is a scheduled episode of a season of a show.
+type Timeslot = schedule.Timeslot
 
-type TracklistItem struct {
-	Track
-	Album        Album `json:"album"`
-	EditLink     Link  `json:"editlink"`
-	DeleteLink   Link  `json:"deletelink"`
-	Time         time.Time
-	TimeRaw      int64 `json:"time"`
-	StartTime    time.Time
-	StartTimeRaw string `json:"starttime"`
-	AudioLogID   uint   `json:"audiologid"`
-}
+// TracklistItem is a track played during a timeslot.
+type TracklistItem = schedule.TracklistItem
 
 func (s *Session) GetCurrentAndNext() (*CurrentAndNext, error) {
-	data, err := s.apiRequest("/timeslot/currentandnext", []string{})
-	if err != nil {
-		return nil, err
-	}
 	var currentAndNext CurrentAndNext
-	err = json.Unmarshal(*data, &currentAndNext)
+	err := s.apiRequestInto("/timeslot/currentandnext", []string{}, &currentAndNext)
 	if err != nil {
 		return nil, err
 	}
@@ -67,41 +33,49 @@ func (s *Session) GetCurrentAndNext() (*CurrentAndNext, error) {
 	return &currentAndNext, nil
 }
 
-func (s *Session) GetTimeslot(id int) (timeslot Timeslot, err error) {
+func (s *Session) GetTimeslot(id TimeslotID) (timeslot Timeslot, err error) {
 	data, err := s.apiRequest(fmt.Sprintf("/timeslot/%d", id), []string{})
 	if err != nil {
 		return
 	}
 	err = json.Unmarshal(*data, &timeslot)
-	timeslot.Time = time.Unix(timeslot.TimeRaw, 0)
-	timeslot.FirstTime, err = time.Parse("02/01/2006 15:04", timeslot.FirstTimeRaw)
 	if err != nil {
 		return
 	}
-	timeslot.Submitted, err = time.Parse("02/01/2006 15:04", timeslot.SubmittedRaw)
+	err = parseTimeslotTimes(&timeslot)
+	return
+}
+
+// parseTimeslotTimes fills in the parsed times of the timeslot.
+func parseTimeslotTimes(timeslot *Timeslot) (err error) {
+	timeslot.Time = time.Unix(timeslot.TimeRaw, 0)
+	timeslot.FirstTime, err = time.Parse(dateTimeLayout, timeslot.FirstTimeRaw)
 	if err != nil {
 		return
 	}
-	timeslot.StartTime, err = time.Parse("02/01/2006 15:04", timeslot.StartTimeRaw)
+	timeslot.Submitted, err = time.Parse(dateTimeLayout, timeslot.SubmittedRaw)
 	if err != nil {
 		return
 	}
-	timeslot.Duration, err = parseDuration("15:04:05", timeslot.DurationRaw)
+	timeslot.StartTime, err = time.Parse(dateTimeLayout, timeslot.StartTimeRaw)
 	if err != nil {
 		return
 	}
+	timeslot.Duration, err = parseDuration(durationLayout, timeslot.DurationRaw)
 	return
 }
 
-func (s *Session) GetTrackListForTimeslot(id int) (tracklist []TracklistItem, err error) {
-	data, err := s.apiRequest(fmt.Sprintf("/tracklistItem/tracklistfortimeslot/%d", id), []string{})
+// GetTracklistForTimeslot gets the tracks played during the timeslot with the given ID.
+//
+// This consumes one API request.
+func (s *Session) GetTracklistForTimeslot(id TimeslotID) (tracklist []TracklistItem, err error) {
+	err = s.apiRequestInto(fmt.Sprintf("/tracklistItem/tracklistfortimeslot/%d", id), []string{}, &tracklist)
 	if err != nil {
 		return
 	}
-	err = json.Unmarshal(*data, &tracklist)
 	for k, v := range tracklist {
 		tracklist[k].Time = time.Unix(tracklist[k].TimeRaw, 0)
-		tracklist[k].StartTime, err = time.Parse("02/01/2006 15:04:05", v.StartTimeRaw)
+		tracklist[k].StartTime, err = time.Parse(tracklistTimeLayout, v.StartTimeRaw)
 		if err != nil {
 			return nil, err
 		}
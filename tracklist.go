@@ -0,0 +1,150 @@
+package myradio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ErrTracklistMismatch is the error returned by SubmitTracklist when MyRadio
+// doesn't give one result for each submitted entry.
+var ErrTracklistMismatch = errors.New("Tracklist results don't match the submitted entries")
+
+// TracklistEntry is a track played in a timeslot, to be added to its tracklist.
+//
+// Either TrackID should be set, for a track in the library, or Artist and
+// Title, for one that isn't.
+type TracklistEntry struct {
+	TrackID TrackID   `json:"trackid,omitempty"`
+	Artist  string    `json:"artist,omitempty"`
+	Title   string    `json:"title,omitempty"`
+	Album   string    `json:"album,omitempty"`
+	Time    time.Time `json:"-"`
+	TimeRaw int64     `json:"time"`
+}
+
+// TracklistEntryResult is the outcome of adding one TracklistEntry.
+type TracklistEntryResult struct {
+	// AudioLogID is the ID of the new tracklist item, if it was added.
+	AudioLogID uint `json:"audiologid,omitempty"`
+	// Error is why the entry couldn't be added, if it wasn't.
+	Error string `json:"error,omitempty"`
+}
+
+// OK returns whether the entry was added.
+//
+// This consumes no API requests.
+func (r TracklistEntryResult) OK() bool {
+	return r.Error == ""
+}
+
+// SubmitTracklist adds all of the given entries to the tracklist of the
+// timeslot with the given ID.
+//
+// Entries are added independently, so some may fail while others succeed.
+// The returned results are in the same order as the entries.
+//
+// If the request as a whole fails, an error is returned with no results, and
+// nothing was added.
+// If MyRadio accepts the request but doesn't give one result per entry,
+// ErrTracklistMismatch is returned along with whatever results it did give,
+// as some entries may have been added; the tracklist should be checked with
+// GetTracklistForTimeslot before resubmitting.
+//
+// This consumes one API request.
+func (s *Session) SubmitTracklist(timeslotid TimeslotID, entries []TracklistEntry) ([]TracklistEntryResult, error) {
+	data, err := s.apiRequestJSON("POST", fmt.Sprintf("/timeslot/%d/tracklist", timeslotid), entries)
+	if err != nil {
+		return nil, err
+	}
+	var results []TracklistEntryResult
+	if data != nil {
+		err = json.Unmarshal(*data, &results)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(results) != len(entries) {
+		return results, ErrTracklistMismatch
+	}
+	return results, nil
+}
+
+// maxWatchBackoff is how many times longer than its interval WatchTracklist
+// waits between polls when nothing is happening.
+const maxWatchBackoff = 8
+
+// WatchTracklist polls the tracklist of the timeslot with the given ID, and
+// sends each newly logged track on the returned channel, in the order the
+// tracks were logged.
+//
+// Tracks logged before the call are included.
+// Polls start every interval, and back off to up to maxWatchBackoff times as
+// long while nothing new is logged (or polls fail), going back to every
+// interval as soon as a track is logged.
+// An interval of zero or less polls every second.
+// Polling carries on after an error, which is sent on the error channel if
+// there is room; the channel holds one error, and any more are dropped until
+// it is read.
+// Both channels are closed once ctx is done.
+//
+// This consumes one API request per poll.
+func (s *Session) WatchTracklist(ctx context.Context, timeslotid TimeslotID, interval time.Duration) (<-chan TracklistItem, <-chan error) {
+	items := make(chan TracklistItem)
+	errs := make(chan error, 1)
+	interval = watchInterval(interval)
+	go func() {
+		defer close(items)
+		defer close(errs)
+		seen := make(map[uint]bool)
+		wait := interval
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				return
+			}
+
+			tracklist, err := s.GetTracklistForTimeslot(timeslotid)
+			if err != nil {
+				select {
+				case errs <- err:
+				default:
+				}
+			}
+			var fresh []TracklistItem
+			for _, item := range tracklist {
+				if !seen[item.AudioLogID] {
+					seen[item.AudioLogID] = true
+					fresh = append(fresh, item)
+				}
+			}
+			sort.SliceStable(fresh, func(i, j int) bool {
+				return fresh[i].Time.Before(fresh[j].Time)
+			})
+			for _, item := range fresh {
+				select {
+				case items <- item:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if len(fresh) > 0 {
+				wait = interval
+			} else if wait < maxWatchBackoff*interval {
+				wait *= 2
+				if wait > maxWatchBackoff*interval {
+					wait = maxWatchBackoff * interval
+				}
+			}
+			timer.Reset(wait)
+		}
+	}()
+	return items, errs
+}
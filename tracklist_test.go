@@ -0,0 +1,140 @@
+package myradio
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSubmitTracklist(t *testing.T) {
+	var got []map[string]interface{}
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/timeslot/42/tracklist" || r.Header.Get("Content-Type") != "application/json" {
+			t.Error("Got:", r.Method, r.URL.Path, r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		writePayload(w, []TracklistEntryResult{{AudioLogID: 900}, {Error: "Unknown track"}})
+	}))
+
+	entries := []TracklistEntry{
+		{Artist: "Foo", Title: "Bar", Time: time.Unix(1500000000, 0)},
+		{TrackID: 404, TimeRaw: 1500000200},
+	}
+	results, err := s.SubmitTracklist(42, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedBody := []map[string]interface{}{
+		{"artist": "Foo", "title": "Bar", "time": float64(1500000000)},
+		{"trackid": float64(404), "time": float64(1500000200)},
+	}
+	if !reflect.DeepEqual(got, expectedBody) {
+		t.Error("Got:", got, ", Expected:", expectedBody)
+	}
+	if len(results) != 2 || !results[0].OK() || results[0].AudioLogID != 900 || results[1].OK() {
+		t.Error("Got:", results)
+	}
+}
+
+func TestSubmitTracklistMismatch(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePayload(w, []TracklistEntryResult{{AudioLogID: 900}})
+	}))
+
+	entries := []TracklistEntry{{TrackID: 12345, TimeRaw: 1500000000}, {TrackID: 404, TimeRaw: 1500000200}}
+	results, err := s.SubmitTracklist(42, entries)
+	if err != ErrTracklistMismatch {
+		t.Error("Got:", err, ", Expected:", ErrTracklistMismatch)
+	}
+	// What was added is still reported.
+	expected := []TracklistEntryResult{{AudioLogID: 900}}
+	if !reflect.DeepEqual(results, expected) {
+		t.Error("Got:", results, ", Expected:", expected)
+	}
+}
+
+func TestWatchTracklist(t *testing.T) {
+	item := func(id uint, at int64) TracklistItem {
+		return TracklistItem{Track: testTrack, AudioLogID: id, TimeRaw: at, StartTimeRaw: "26/10/2015 07:00:00"}
+	}
+	var (
+		mu    sync.Mutex
+		polls []time.Time
+	)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		polls = append(polls, time.Now())
+		n := len(polls)
+		mu.Unlock()
+		// The tracklist is returned newest first, and grows on the third poll.
+		tracklist := []TracklistItem{item(9002, 1445843100), item(9001, 1445842900)}
+		if n >= 3 {
+			tracklist = append([]TracklistItem{item(9003, 1445843300)}, tracklist...)
+		}
+		writePayload(w, tracklist)
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	items, errs := s.WatchTracklist(ctx, 303, 5*time.Millisecond)
+
+	for _, expected := range []uint{9001, 9002, 9003} {
+		select {
+		case got := <-items:
+			if got.AudioLogID != expected {
+				t.Error("Got item:", got.AudioLogID, ", Expected:", expected)
+			}
+		case err := <-errs:
+			t.Fatal(err)
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for item", expected)
+		}
+	}
+
+	// With nothing new, polling should back off.
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	for range items {
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(polls) > 12 {
+		t.Error("Got:", len(polls), "polls in 100ms, Expected: polling to back off")
+	}
+	if last := polls[len(polls)-1].Sub(polls[len(polls)-2]); last < 20*time.Millisecond {
+		t.Error("Got:", last, "between the last polls, Expected: at least 20ms")
+	}
+}
+
+func TestWatchTracklistZeroInterval(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		polls int
+	)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		polls++
+		mu.Unlock()
+		writePayload(w, []TracklistItem{})
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	items, _ := s.WatchTracklist(ctx, 303, 0)
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	for range items {
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if polls != 1 {
+		t.Error("Got:", polls, "polls in 100ms, Expected: 1, not polling in a tight loop")
+	}
+}
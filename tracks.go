@@ -2,120 +2,49 @@ package myradio
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/tracks"
 )
 
 // Album contains information about an album in the URY track database.
-type Album struct {
-	// ID is the unique database ID of the album.
-	ID uint64 `json:recordid`
-
-	// Title is the title of the track.
-	Title string `json:title`
-	// Artist is the primary credited artist of the track.
-	Artist string `json:artist`
-
-	// DateAdded is the date on which the album entered the MyRadio library.
-	DateAdded string `json:date_added`
-	// DateReleased is the date on which the album was released.
-	DateReleased string `json:date_released`
-	// LastModified is the date on which the album was last modified.
-	LastModified string `json:last_modified`
-
-	// CDID is the ID of the CD, if this track comes from one.
-	CDID string `json:cdid`
-
-	// Location is the location of the physical copy of this album, if any.
-	Location string `json:location`
-	// ShelfLetter is the shelf on which the physical copy resides, if any.
-	ShelfLetter string `json:shelf_letter`
-	// ShelfNumber is the position on the shelf on which the physical copy resides, if any.
-	ShelfNumber string `json:shelf_number`
-
-	// Format is a single-character code identifying the physical format.
-	Format string `json:format`
-	// Medium is a single-character code identifying the physical medium.
-	Medium string `json:media`
-
-	// AddingMember is the ID of the member who added this album.
-	AddingMember uint64 `json:member_add`
-	// EditingMember is the ID of the member who last modified this album.
-	EditingMember uint64 `json:member_edit`
-
-	// RecordLabel is the record label responsible for this album.
-	RecordLabel string `json:record_label`
-
-	// Status is the digitisation status code for this album.
-	Status string `json:status`
-}
+type Album = tracks.Album
 
 // Track contains information about a track in the URY track database.
-type Track struct {
-	// ID is the unique database ID of the track.
-	ID uint64 `json:trackid`
-
-	// Title is the title of the track.
-	Title string `json:title`
-	// Artist is the primary credited artist of the track.
-	Artist string `json:artist`
-	// Type is the type ('central' etc.) of the track.
-	Type string `json:type`
-	// Length is the length of the track, in hours:minutes:seconds.
-	Length string `json:length`
-	// Intro is length of the track's intro, in seconds.
-	Intro uint64 `json:intro`
-	// IsClean is true if this track is clean (no expletives).
-	IsClean bool `json:clean`
-	// IsDigitised is true if this track is available in the playout system.
-	IsDigitised bool `json:digitised`
-}
+type Track = tracks.Track
 
-// GetAlbum tries to get the Album for the given Track.
+// SetTrackIntro sets the length of the intro of the track with the given ID.
+//
+// MyRadio stores intros in whole seconds, so the length is rounded to the nearest second.
 //
 // This consumes one API request.
-func (t *Track) GetAlbum(s *Session) (*Album, error) {
-	return s.GetTrackAlbum(t.ID)
+func (s *Session) SetTrackIntro(trackid TrackID, intro time.Duration) error {
+	return s.setTrackSeconds(fmt.Sprintf("/track/%d/intro", trackid), "intro", intro)
 }
 
-// LengthSec returns the track's length in seconds.
+// SetTrackOutro sets the length of the outro of the track with the given ID.
 //
-// Returns an error if the track's length is ill-formed.
+// MyRadio stores outros in whole seconds, so the length is rounded to the nearest second.
 //
-// This consumes no API requests.
-func (t *Track) LengthSec() (uint64, error) {
-	var hours, minutes, seconds uint64
-
-	_, err := fmt.Sscan(strings.Replace(t.Length, ":", " ", -1), &hours, &minutes, &seconds)
-	if err != nil {
-		return 0, err
-	}
-
-	return (hours * 60 * 60) + (minutes * 60) + seconds, nil
+// This consumes one API request.
+func (s *Session) SetTrackOutro(trackid TrackID, outro time.Duration) error {
+	return s.setTrackSeconds(fmt.Sprintf("/track/%d/outro", trackid), "outro", outro)
 }
 
-// LengthUsec returns the track's length in microseconds.
-//
-// This is not precise, as it is derived from the length in seconds.
-// Consider estimating the correct length from the track file itself.
-//
-// Returns an error if the track's length is ill-formed.
-//
-// This consumes no API requests.
-func (t *Track) LengthUsec() (uint64, error) {
-	secs, err := t.LengthSec()
-	if err != nil {
-		return 0, err
+// setTrackSeconds sets the named parameter at the given endpoint to d, in whole seconds.
+func (s *Session) setTrackSeconds(endpoint, name string, d time.Duration) error {
+	if d < 0 {
+		return errors.New("Negative " + name + " length")
 	}
-
-	return secs * 1000000, nil
-}
-
-// IntroUsec returns the track's intro in microseconds.
-//
-// This consumes no API requests.
-func (t *Track) IntroUsec() uint64 {
-	return t.Intro * 1000000
+	params := url.Values{
+		name: []string{strconv.FormatInt(int64(d.Round(time.Second)/time.Second), 10)},
+	}
+	_, err := s.apiRequestWithParams("PUT", endpoint, nil, params)
+	return err
 }
 
 // GetTrack tries to get the Track with the given ID.
@@ -123,7 +52,7 @@ func (t *Track) IntroUsec() uint64 {
 // Track IDs are unique, so we do not need the record ID.
 //
 // This consumes one API request.
-func (s *Session) GetTrack(trackid uint64) (*Track, error) {
+func (s *Session) GetTrack(trackid TrackID) (*Track, error) {
 	data, err := s.apiRequest(fmt.Sprintf("/track/%d", trackid), nil)
 	if err != nil {
 		return nil, err
@@ -139,7 +68,7 @@ func (s *Session) GetTrack(trackid uint64) (*Track, error) {
 // GetTrackTitle tries to get the title of the track with the given ID.
 //
 // This consumes one API request.
-func (s *Session) GetTrackTitle(trackid uint64) (string, error) {
+func (s *Session) GetTrackTitle(trackid TrackID) (string, error) {
 	data, err := s.apiRequest(fmt.Sprintf("/track/%d/title", trackid), nil)
 	if err != nil {
 		return "", err
@@ -155,7 +84,7 @@ func (s *Session) GetTrackTitle(trackid uint64) (string, error) {
 // GetTrackAlbum tries to get the Album of the track with the given ID.
 //
 // This consumes one API request.
-func (s *Session) GetTrackAlbum(trackid uint64) (*Album, error) {
+func (s *Session) GetTrackAlbum(trackid TrackID) (*Album, error) {
 	data, err := s.apiRequest(fmt.Sprintf("/track/%d/album", trackid), nil)
 	if err != nil {
 		return nil, err
@@ -167,3 +96,121 @@ func (s *Session) GetTrackAlbum(trackid uint64) (*Album, error) {
 	}
 	return album, nil
 }
+
+// getTrackPage gets a page of a listing of tracks from the given endpoint.
+func (s *Session) getTrackPage(endpoint string, limit, offset int, params url.Values) (*Page[Track], error) {
+	return getPage[Track](s, endpoint, nil, limit, offset, params)
+}
+
+// GetTracksByArtistPage gets a page of up to limit tracks by the given
+// artist, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetTracksByArtistPage(artist string, limit, offset int) (*Page[Track], error) {
+	return s.getTrackPage("/track/search", limit, offset, url.Values{"artist": []string{artist}})
+}
+
+// GetTracksByArtist gets up to limit tracks by the given artist, skipping
+// the first offset.
+//
+// A limit of 0 means no limit.
+// Use GetTracksByArtistPage to find out whether there are more.
+//
+// This consumes one API request.
+func (s *Session) GetTracksByArtist(artist string, limit, offset int) ([]Track, error) {
+	page, err := s.GetTracksByArtistPage(artist, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// GetTracksByRecordPage gets a page of up to limit tracks on the album with
+// the given ID, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request.
+func (s *Session) GetTracksByRecordPage(recordid RecordID, limit, offset int) (*Page[Track], error) {
+	return s.getTrackPage(fmt.Sprintf("/album/%d/tracks", recordid), limit, offset, url.Values{})
+}
+
+// GetTracksByRecord gets up to limit tracks on the album with the given ID,
+// skipping the first offset.
+//
+// A limit of 0 means no limit.
+// Use GetTracksByRecordPage to find out whether there are more.
+//
+// This consumes one API request.
+func (s *Session) GetTracksByRecord(recordid RecordID, limit, offset int) ([]Track, error) {
+	page, err := s.GetTracksByRecordPage(recordid, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// RandomTrackCriteria restricts the tracks GetRandomTrack may pick.
+//
+// The zero value allows any digitised track.
+type RandomTrackCriteria struct {
+	// CleanOnly restricts the pick to clean tracks.
+	CleanOnly bool
+	// MinLength and MaxLength, if not zero, bound the length of the track.
+	MinLength time.Duration
+	MaxLength time.Duration
+	// Type, if not empty, restricts the pick to tracks of the given type.
+	Type TrackType
+}
+
+// params returns the parameters asking for tracks matching the criteria.
+func (c RandomTrackCriteria) params() (url.Values, error) {
+	if c.MinLength < 0 || c.MaxLength < 0 || (c.MaxLength != 0 && c.MaxLength < c.MinLength) {
+		return nil, errors.New("Invalid track length range")
+	}
+	params := url.Values{}
+	if c.CleanOnly {
+		params.Set("clean", "true")
+	}
+	if c.MinLength != 0 {
+		params.Set("minlength", strconv.FormatInt(int64(c.MinLength/time.Second), 10))
+	}
+	if c.MaxLength != 0 {
+		params.Set("maxlength", strconv.FormatInt(int64(c.MaxLength/time.Second), 10))
+	}
+	if c.Type != "" {
+		if !c.Type.Valid() {
+			return nil, ErrInvalidTrackType
+		}
+		params.Set("type", string(c.Type))
+	}
+	return params, nil
+}
+
+// GetRandomTrack picks a random digitised track matching the given criteria,
+// in the same way as the jukebox.
+//
+// Returns an error if no track matches.
+//
+// This consumes one API request.
+func (s *Session) GetRandomTrack(criteria RandomTrackCriteria) (*Track, error) {
+	params, err := criteria.params()
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.apiRequestWithParams("GET", "/track/random", nil, params)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("No tracks match")
+	}
+	track := new(Track)
+	err = json.Unmarshal(*data, track)
+	if err != nil {
+		return nil, err
+	}
+	return track, nil
+}
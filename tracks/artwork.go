@@ -0,0 +1,16 @@
+package tracks
+
+// ArtworkStatus is how far MyRadio has got with resizing an album's artwork.
+type ArtworkStatus string
+
+const (
+	// ArtworkNone is an album with no artwork.
+	ArtworkNone ArtworkStatus = "none"
+	// ArtworkProcessing is artwork that MyRadio is still resizing.
+	ArtworkProcessing ArtworkStatus = "processing"
+	// ArtworkReady is artwork that has been resized, and is in use.
+	ArtworkReady ArtworkStatus = "ready"
+	// ArtworkFailed is artwork that MyRadio could not process, for example
+	// because it isn't a valid image.
+	ArtworkFailed ArtworkStatus = "failed"
+)
@@ -0,0 +1,31 @@
+package tracks
+
+import "strconv"
+
+// TrackID is the ID of a Track in the URY track database.
+type TrackID uint64
+
+// RecordID is the ID of an Album (a record) in the URY track database.
+type RecordID uint64
+
+// String returns the ID in decimal.
+func (id TrackID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// String returns the ID in decimal.
+func (id RecordID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// ParseTrackID parses a TrackID written in decimal, as in a URL.
+func ParseTrackID(s string) (TrackID, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	return TrackID(id), err
+}
+
+// ParseRecordID parses a RecordID written in decimal, as in a URL.
+func ParseRecordID(s string) (RecordID, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	return RecordID(id), err
+}
@@ -0,0 +1,27 @@
+package tracks
+
+// LibraryStats contains summary statistics about the URY track database.
+type LibraryStats struct {
+	// TotalTracks is the number of tracks in the library.
+	TotalTracks uint64 `json:"total_tracks"`
+	// DigitisedTracks is the number of tracks available in the playout system.
+	DigitisedTracks uint64 `json:"digitised_tracks"`
+	// TotalAlbums is the number of albums in the library.
+	TotalAlbums uint64 `json:"total_albums"`
+	// AlbumsByMedium maps each single-character medium code (see Album.Medium)
+	// to the number of albums in that medium.
+	AlbumsByMedium map[string]uint64 `json:"albums_by_medium"`
+	// RecentAdditions contains the albums most recently added to the library,
+	// newest first.
+	RecentAdditions []Album `json:"recent_additions"`
+}
+
+// DigitisedPercent returns the percentage of tracks in the library that are digitised.
+//
+// This consumes no API requests.
+func (l *LibraryStats) DigitisedPercent() float64 {
+	if l.TotalTracks == 0 {
+		return 0
+	}
+	return float64(l.DigitisedTracks) / float64(l.TotalTracks) * 100
+}
@@ -0,0 +1,23 @@
+package tracks
+
+import (
+	"testing"
+)
+
+func TestDigitisedPercent(t *testing.T) {
+	tests := []struct {
+		total, digitised uint64
+		expected         float64
+	}{
+		{0, 0, 0},
+		{200, 50, 25},
+		{80000, 80000, 100},
+	}
+
+	for _, test := range tests {
+		stats := LibraryStats{TotalTracks: test.total, DigitisedTracks: test.digitised}
+		if got := stats.DigitisedPercent(); got != test.expected {
+			t.Error("Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
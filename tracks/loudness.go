@@ -0,0 +1,24 @@
+package tracks
+
+// ReplayGainReference is the ReplayGain 2.0 reference loudness, in LUFS.
+const ReplayGainReference = -18.0
+
+// TrackLoudness contains loudness normalisation metadata for a track.
+type TrackLoudness struct {
+	// IntegratedLUFS is the integrated (programme) loudness of the track, in LUFS.
+	IntegratedLUFS float64 `json:"integrated_lufs"`
+	// TruePeak is the true peak level of the track, in dBTP.
+	TruePeak float64 `json:"true_peak"`
+
+	// TrackGain is the ReplayGain track gain, in dB.
+	TrackGain float64 `json:"replaygain_track_gain"`
+	// TrackPeak is the ReplayGain track peak, as a linear sample value.
+	TrackPeak float64 `json:"replaygain_track_peak"`
+}
+
+// GainTo returns the gain, in dB, needed to bring the track to the given loudness in LUFS.
+//
+// This consumes no API requests.
+func (l *TrackLoudness) GainTo(target float64) float64 {
+	return target - l.IntegratedLUFS
+}
@@ -0,0 +1,23 @@
+package tracks
+
+import (
+	"testing"
+)
+
+func TestGainTo(t *testing.T) {
+	tests := []struct {
+		lufs, target, expected float64
+	}{
+		{-11.5, ReplayGainReference, -6.5},
+		{-23, ReplayGainReference, 5},
+		{-14, -14, 0},
+		{-20, -16, 4},
+	}
+
+	for _, test := range tests {
+		l := TrackLoudness{IntegratedLUFS: test.lufs}
+		if got := l.GainTo(test.target); got != test.expected {
+			t.Error("LUFS:", test.lufs, ", Target:", test.target, ", Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package tracks
+
+import (
+	"encoding/json"
+)
+
+// The MarshalJSON methods in this file encode types back into the same form
+// the API uses, as the ones in the myradio package do.
+
+func (t TrackSegue) MarshalJSON() ([]byte, error) {
+	type trackSegue TrackSegue
+	if t.FadeOut != 0 {
+		t.FadeOutRaw = t.FadeOut.Seconds()
+	}
+	if t.Overlap != 0 {
+		t.OverlapRaw = t.Overlap.Seconds()
+	}
+	return json.Marshal(trackSegue(t))
+}
@@ -0,0 +1,59 @@
+package tracks
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Nullable is a value that MyRadio may leave null, such as the shelf
+// location of an album with no physical copy.
+//
+// Unlike decoding into a plain T, this keeps a null (or missing) value
+// distinguishable from a real zero value.
+type Nullable[T any] struct {
+	Value T
+	// Valid is true if the value is set (not null).
+	Valid bool
+}
+
+// NewNullable returns a Nullable set to the given value.
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{Value: v, Valid: true}
+}
+
+// Get returns the value, and whether it is set.
+//
+// This consumes no API requests.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.Value, n.Valid
+}
+
+// Or returns the value if it is set, and def otherwise.
+//
+// This consumes no API requests.
+func (n Nullable[T]) Or(def T) T {
+	if !n.Valid {
+		return def
+	}
+	return n.Value
+}
+
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		var zero T
+		n.Value, n.Valid = zero, false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
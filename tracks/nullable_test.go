@@ -0,0 +1,45 @@
+package tracks
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullable(t *testing.T) {
+	tests := []struct {
+		json     string
+		expected Nullable[string]
+	}{
+		{`{"location": "Library"}`, NewNullable("Library")},
+		{`{"location": ""}`, NewNullable("")},
+		{`{"location": null}`, Nullable[string]{}},
+		{`{}`, Nullable[string]{}},
+	}
+
+	for _, test := range tests {
+		var got struct {
+			Location Nullable[string] `json:"location"`
+		}
+		if err := json.Unmarshal([]byte(test.json), &got); err != nil || got.Location != test.expected {
+			t.Error("JSON:", test.json, ", Got:", got.Location, ", Error:", err, ", Expected:", test.expected)
+		}
+	}
+
+	for _, n := range []Nullable[uint64]{{}, NewNullable[uint64](0), NewNullable[uint64](1234)} {
+		data, err := json.Marshal(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Nullable[uint64]
+		if err = json.Unmarshal(data, &got); err != nil || got != n {
+			t.Error("Got:", got, ", Error:", err, ", Expected:", n)
+		}
+	}
+
+	if v := (Nullable[string]{}).Or("Unknown"); v != "Unknown" {
+		t.Error("Got:", v, ", Expected: Unknown")
+	}
+	if v, ok := NewNullable("O").Get(); !ok || v != "O" {
+		t.Error("Got:", v, ok, ", Expected: O true")
+	}
+}
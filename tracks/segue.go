@@ -0,0 +1,15 @@
+package tracks
+
+import (
+	"time"
+)
+
+// TrackSegue contains hints for automatically segueing out of a track.
+type TrackSegue struct {
+	// FadeOut is the point, from the start of the track, at which to start fading out.
+	FadeOut    time.Duration `json:"-"`
+	FadeOutRaw float64       `json:"fade_out"`
+	// Overlap is how long before the end of the track the next track should start.
+	Overlap    time.Duration `json:"-"`
+	OverlapRaw float64       `json:"overlap"`
+}
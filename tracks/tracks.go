@@ -0,0 +1,168 @@
+// Package tracks holds the MyRadio types describing the URY track database:
+// tracks, albums and what playout knows about them.
+//
+// Most programs should use the myradio package, which aliases these types
+// and has the Session methods for fetching them.
+package tracks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/users"
+)
+
+// Album contains information about an album in the URY track database.
+type Album struct {
+	// ID is the unique database ID of the album.
+	ID RecordID `json:"recordid"`
+
+	// Title is the title of the track.
+	Title string `json:"title"`
+	// Artist is the primary credited artist of the track.
+	Artist string `json:"artist"`
+
+	// DateAdded is the date on which the album entered the MyRadio library.
+	DateAdded string `json:"date_added"`
+	// DateReleased is the date on which the album was released, if known.
+	DateReleased Nullable[string] `json:"date_released"`
+	// LastModified is the date on which the album was last modified.
+	LastModified string `json:"last_modified"`
+
+	// CDID is the ID of the CD, if this track comes from one.
+	CDID Nullable[string] `json:"cdid"`
+
+	// Location is the location of the physical copy of this album, if any.
+	Location Nullable[string] `json:"location"`
+	// ShelfLetter is the shelf on which the physical copy resides, if any.
+	ShelfLetter Nullable[string] `json:"shelf_letter"`
+	// ShelfNumber is the position on the shelf on which the physical copy resides, if any.
+	ShelfNumber Nullable[string] `json:"shelf_number"`
+
+	// Format is a single-character code identifying the physical format.
+	Format string `json:"format"`
+	// Medium is a single-character code identifying the physical medium.
+	Medium string `json:"media"`
+
+	// AddingMember is the ID of the member who added this album.
+	AddingMember users.UserID `json:"member_add"`
+	// EditingMember is the ID of the member who last modified this album, if anyone has.
+	EditingMember Nullable[users.UserID] `json:"member_edit"`
+
+	// RecordLabel is the record label responsible for this album, if known.
+	RecordLabel Nullable[string] `json:"record_label"`
+
+	// Status is the digitisation status code for this album.
+	Status string `json:"status"`
+}
+
+// Track contains information about a track in the URY track database.
+type Track struct {
+	// ID is the unique database ID of the track.
+	ID TrackID `json:"trackid"`
+
+	// Title is the title of the track.
+	Title string `json:"title"`
+	// Artist is the primary credited artist of the track.
+	Artist string `json:"artist"`
+	// Type is the type (TrackTypeCentral etc.) of the track.
+	Type TrackType `json:"type"`
+	// Length is the length of the track, in hours:minutes:seconds.
+	Length string `json:"length"`
+	// Intro is length of the track's intro, in seconds.
+	Intro uint64 `json:"intro"`
+	// Outro is length of the track's outro, in seconds.
+	Outro uint64 `json:"outro"`
+	// IsClean is true if this track is clean (no expletives).
+	IsClean bool `json:"clean"`
+	// IsDigitised is true if this track is available in the playout system.
+	IsDigitised bool `json:"digitised"`
+}
+
+// AlbumGetter looks up the albums of tracks; a *myradio.Session is one.
+type AlbumGetter interface {
+	GetTrackAlbum(trackid TrackID) (*Album, error)
+}
+
+// GetAlbum tries to get the Album for the given Track.
+//
+// This consumes one API request.
+func (t *Track) GetAlbum(g AlbumGetter) (*Album, error) {
+	return g.GetTrackAlbum(t.ID)
+}
+
+// LengthSec returns the track's length in seconds.
+//
+// Returns an error if the track's length is ill-formed.
+//
+// This consumes no API requests.
+func (t *Track) LengthSec() (uint64, error) {
+	var hours, minutes, seconds uint64
+
+	_, err := fmt.Sscan(strings.Replace(t.Length, ":", " ", -1), &hours, &minutes, &seconds)
+	if err != nil {
+		return 0, err
+	}
+
+	return (hours * 60 * 60) + (minutes * 60) + seconds, nil
+}
+
+// LengthDuration returns the track's length.
+//
+// This is not precise, as it is derived from the length in seconds.
+// Consider estimating the correct length from the track file itself.
+//
+// Returns an error if the track's length is ill-formed.
+//
+// This consumes no API requests.
+func (t *Track) LengthDuration() (time.Duration, error) {
+	secs, err := t.LengthSec()
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(secs) * time.Second, nil
+}
+
+// LengthUsec returns the track's length in microseconds.
+//
+// This is not precise, as it is derived from the length in seconds.
+// Consider estimating the correct length from the track file itself.
+//
+// Returns an error if the track's length is ill-formed.
+//
+// This consumes no API requests.
+//
+// Deprecated: use LengthDuration.
+func (t *Track) LengthUsec() (uint64, error) {
+	secs, err := t.LengthSec()
+	if err != nil {
+		return 0, err
+	}
+
+	return secs * 1000000, nil
+}
+
+// IntroDuration returns the length of the track's intro.
+//
+// This consumes no API requests.
+func (t *Track) IntroDuration() time.Duration {
+	return time.Duration(t.Intro) * time.Second
+}
+
+// OutroDuration returns the length of the track's outro.
+//
+// This consumes no API requests.
+func (t *Track) OutroDuration() time.Duration {
+	return time.Duration(t.Outro) * time.Second
+}
+
+// IntroUsec returns the track's intro in microseconds.
+//
+// This consumes no API requests.
+//
+// Deprecated: use IntroDuration.
+func (t *Track) IntroUsec() uint64 {
+	return t.Intro * 1000000
+}
@@ -0,0 +1,20 @@
+package tracks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTrackDurations(t *testing.T) {
+	track := Track{Length: "00:04:18", Intro: 15, Outro: 20}
+	length, err := track.LengthDuration()
+	if err != nil || length != 4*time.Minute+18*time.Second {
+		t.Error("Got:", length, ", Error:", err)
+	}
+	if got := track.IntroDuration(); got != 15*time.Second {
+		t.Error("Got:", got, ", Expected:", 15*time.Second)
+	}
+	if got := track.OutroDuration(); got != 20*time.Second {
+		t.Error("Got:", got, ", Expected:", 20*time.Second)
+	}
+}
@@ -0,0 +1,42 @@
+package tracks
+
+import (
+	"errors"
+)
+
+// TrackType is the kind of item a track in the library is, which decides how
+// playout handles it.
+type TrackType string
+
+const (
+	// TrackTypeCentral is a song in the central music library.
+	TrackTypeCentral TrackType = "central"
+	// TrackTypeJingle is a station jingle or ident.
+	TrackTypeJingle TrackType = "jingle"
+	// TrackTypeBed is a music bed, played under speech.
+	TrackTypeBed TrackType = "bed"
+	// TrackTypeAdvert is an advert or trail.
+	TrackTypeAdvert TrackType = "advert"
+)
+
+// ErrInvalidTrackType is the error returned when asked to use a track type
+// MyRadio doesn't have.
+var ErrInvalidTrackType = errors.New("Invalid track type")
+
+// Valid returns true if t is one of the known track types.
+//
+// This consumes no API requests.
+func (t TrackType) Valid() bool {
+	switch t {
+	case TrackTypeCentral, TrackTypeJingle, TrackTypeBed, TrackTypeAdvert:
+		return true
+	}
+	return false
+}
+
+// IsSong returns true if tracks of type t are songs, rather than station audio.
+//
+// This consumes no API requests.
+func (t TrackType) IsSong() bool {
+	return t == TrackTypeCentral
+}
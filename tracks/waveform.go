@@ -0,0 +1,81 @@
+package tracks
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Waveform contains peak data for drawing a track's waveform.
+type Waveform struct {
+	// PeaksPerSecond is how many peaks there are for each second of audio.
+	PeaksPerSecond int `json:"peaks_per_second"`
+	// Peaks are the peak absolute sample values of each slice of the audio,
+	// scaled to between 0 and 1.
+	Peaks []float64 `json:"peaks"`
+}
+
+// ComputeWaveform computes a Waveform from raw audio, read from pcm as
+// interleaved signed 16-bit little-endian samples at the given sample rate
+// and number of channels.
+//
+// Decoding the track's audio file to PCM is left to the caller.
+//
+// This consumes no API requests.
+func ComputeWaveform(pcm io.Reader, sampleRate, channels, peaksPerSecond int) (*Waveform, error) {
+	if sampleRate <= 0 || channels <= 0 || peaksPerSecond <= 0 || peaksPerSecond > sampleRate {
+		return nil, errors.New("Invalid waveform parameters")
+	}
+
+	waveform := &Waveform{PeaksPerSecond: peaksPerSecond}
+	var (
+		peak int
+		// channel is the channel of the next sample, and frame the number of
+		// whole frames (one sample from each channel) read so far.
+		channel, frame int64
+		// end is the frame the current peak ends at.
+		// Peak i covers frames i*sampleRate/peaksPerSecond up to (i+1)*sampleRate/peaksPerSecond,
+		// computed exactly, so that the peaks don't drift when the sample rate
+		// isn't a multiple of peaksPerSecond.
+		end     = int64(sampleRate) / int64(peaksPerSecond)
+		pending bool
+	)
+	buf := make([]byte, 32*1024)
+	carry := 0
+	for {
+		n, err := pcm.Read(buf[carry:])
+		n += carry
+		whole := n &^ 1
+		for i := 0; i < whole; i += 2 {
+			sample := int(int16(binary.LittleEndian.Uint16(buf[i:])))
+			if sample < 0 {
+				sample = -sample
+			}
+			if sample > peak {
+				peak = sample
+			}
+			pending = true
+			if channel++; channel < int64(channels) {
+				continue
+			}
+			channel = 0
+			if frame++; frame == end {
+				waveform.Peaks = append(waveform.Peaks, float64(peak)/32768)
+				peak, pending = 0, false
+				end = int64(len(waveform.Peaks)+1) * int64(sampleRate) / int64(peaksPerSecond)
+			}
+		}
+		// Keep any odd byte for the next read.
+		carry = copy(buf, buf[whole:n])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if pending {
+		waveform.Peaks = append(waveform.Peaks, float64(peak)/32768)
+	}
+	return waveform, nil
+}
@@ -0,0 +1,57 @@
+package tracks
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+	"testing/iotest"
+)
+
+func TestComputeWaveform(t *testing.T) {
+	// Two seconds of stereo audio at 4Hz, ending with a partial slice.
+	samples := []int16{
+		100, -200, 16384, 0, // first half second
+		-32768, 5, 0, 0, // second half second
+		0, 0, 0, 8192, // third half second
+		-16384, 0, //  partial
+	}
+	var pcm bytes.Buffer
+	binary.Write(&pcm, binary.LittleEndian, samples)
+
+	got, err := ComputeWaveform(&pcm, 4, 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Waveform{PeaksPerSecond: 2, Peaks: []float64{0.5, 1, 0.25, 0.5}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+
+	if _, err = ComputeWaveform(&pcm, 4, 2, 8); err == nil {
+		t.Error("Expected an error with more peaks per second than samples")
+	}
+}
+
+func TestComputeWaveformUnevenWindows(t *testing.T) {
+	// Two seconds of mono audio at 5Hz, with two peaks per second: each peak
+	// covers 2.5 samples, so alternately two and three.
+	samples := []int16{
+		16384, 0, // 0-2
+		-8192, 0, 32767, // 2-5
+		0, 4096, // 5-7
+		0, 0, -32768, // 7-10
+	}
+	var pcm bytes.Buffer
+	binary.Write(&pcm, binary.LittleEndian, samples)
+
+	// Reading a byte at a time splits samples across reads.
+	got, err := ComputeWaveform(iotest.OneByteReader(&pcm), 5, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Waveform{PeaksPerSecond: 2, Peaks: []float64{0.5, 32767.0 / 32768, 0.125, 1}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+}
@@ -0,0 +1,126 @@
+package myradio
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetTracksByArtist(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/track/search" {
+			t.Error("Got request for:", r.URL.Path)
+		}
+		for k, expected := range map[string]string{"artist": "Oasis", "limit": "2", "offset": "4"} {
+			if got := r.FormValue(k); got != expected {
+				t.Error("Got", k, ":", got, ", Expected:", expected)
+			}
+		}
+		writePayload(w, []Track{testTrack, testTrack})
+	}))
+
+	tracks, err := s.GetTracksByArtist("Oasis", 2, 4)
+	if err != nil || len(tracks) != 2 || tracks[0] != testTrack {
+		t.Error("Got:", tracks, ", Error:", err)
+	}
+}
+
+func TestSetTrackIntro(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/track/12345/intro" {
+			t.Error("Got:", r.Method, r.URL.Path)
+		}
+		if got := r.FormValue("intro"); got != "13" {
+			t.Error("Got:", got, ", Expected: 13")
+		}
+		writePayload(w, nil)
+	}))
+
+	if err := s.SetTrackIntro(12345, 12600*time.Millisecond); err != nil {
+		t.Error(err)
+	}
+	if err := s.SetTrackIntro(12345, -time.Second); err == nil {
+		t.Error("Expected an error for a negative intro")
+	}
+}
+
+func TestSetTrackOutro(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/track/12345/outro" {
+			t.Error("Got:", r.Method, r.URL.Path)
+		}
+		if got := r.FormValue("outro"); got != "20" {
+			t.Error("Got:", got, ", Expected: 20")
+		}
+		writePayload(w, nil)
+	}))
+
+	if err := s.SetTrackOutro(12345, 20400*time.Millisecond); err != nil {
+		t.Error(err)
+	}
+	if err := s.SetTrackOutro(12345, -time.Second); err == nil {
+		t.Error("Expected an error for a negative outro")
+	}
+}
+
+func TestGetRandomTrack(t *testing.T) {
+	var got url.Values
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/track/random" {
+			t.Error("Got request for:", r.URL.Path)
+		}
+		got = r.URL.Query()
+		writePayload(w, testTrack)
+	}))
+
+	track, err := s.GetRandomTrack(RandomTrackCriteria{CleanOnly: true, MinLength: 90 * time.Second, MaxLength: 5 * time.Minute, Type: TrackTypeCentral})
+	if err != nil || *track != testTrack {
+		t.Error("Got:", track, ", Error:", err)
+	}
+	for k, expected := range map[string]string{"clean": "true", "minlength": "90", "maxlength": "300", "type": "central"} {
+		if got.Get(k) != expected {
+			t.Error("Got", k, ":", got.Get(k), ", Expected:", expected)
+		}
+	}
+
+	if _, err = s.GetRandomTrack(RandomTrackCriteria{}); err != nil || got.Get("clean") != "" || got.Get("maxlength") != "" {
+		t.Error("Got:", got, ", Error:", err)
+	}
+	if _, err = s.GetRandomTrack(RandomTrackCriteria{MinLength: time.Minute, MaxLength: time.Second}); err == nil {
+		t.Error("Expected an error for an empty length range")
+	}
+}
+
+func TestTrackTypes(t *testing.T) {
+	rec := newRequestRecorder(t)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r, "type")
+		writePayload(w, []Track{testTrack})
+	}))
+
+	if tracks, err := s.GetTracksByType(TrackTypeJingle, 10, 0); err != nil || len(tracks) != 1 {
+		t.Error("Got:", tracks, ", Error:", err)
+	}
+	if err := s.SetTrackType(12345, TrackTypeBed); err != nil {
+		t.Error(err)
+	}
+	for _, err := range []error{
+		s.SetTrackType(12345, "song"),
+		func() error { _, err := s.GetTracksByType("", 10, 0); return err }(),
+		func() error { _, err := s.GetRandomTrack(RandomTrackCriteria{Type: "Central"}); return err }(),
+	} {
+		if err != ErrInvalidTrackType {
+			t.Error("Got:", err, ", Expected:", ErrInvalidTrackType)
+		}
+	}
+
+	expected := []string{"GET /track/search jingle", "PUT /track/12345/type bed"}
+	if got := rec.requests(); !reflect.DeepEqual(got, expected) {
+		t.Error("Got:", got, ", Expected:", expected)
+	}
+	if !TrackTypeCentral.IsSong() || TrackTypeJingle.IsSong() {
+		t.Error("Expected only central tracks to be songs")
+	}
+}
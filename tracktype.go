@@ -0,0 +1,71 @@
+package myradio
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/UniversityRadioYork/myradio-go/tracks"
+)
+
+// TrackType is the kind of item a track in the library is, which decides how
+// playout handles it.
+type TrackType = tracks.TrackType
+
+const (
+	// TrackTypeCentral is a song in the central music library.
+	TrackTypeCentral = tracks.TrackTypeCentral
+	// TrackTypeJingle is a station jingle or ident.
+	TrackTypeJingle = tracks.TrackTypeJingle
+	// TrackTypeBed is a music bed, played under speech.
+	TrackTypeBed = tracks.TrackTypeBed
+	// TrackTypeAdvert is an advert or trail.
+	TrackTypeAdvert = tracks.TrackTypeAdvert
+)
+
+// ErrInvalidTrackType is the error returned when asked to use a track type
+// MyRadio doesn't have.
+var ErrInvalidTrackType = tracks.ErrInvalidTrackType
+
+// GetTracksByTypePage gets a page of up to limit tracks of the given type,
+// skipping the first offset.
+//
+// A limit of 0 means no limit.
+// Returns ErrInvalidTrackType if the type isn't a known one.
+//
+// This consumes one API request.
+func (s *Session) GetTracksByTypePage(t TrackType, limit, offset int) (*Page[Track], error) {
+	if !t.Valid() {
+		return nil, ErrInvalidTrackType
+	}
+	return s.getTrackPage("/track/search", limit, offset, url.Values{"type": []string{string(t)}})
+}
+
+// GetTracksByType gets up to limit tracks of the given type, skipping the
+// first offset.
+//
+// A limit of 0 means no limit.
+// Returns ErrInvalidTrackType if the type isn't a known one.
+// Use GetTracksByTypePage to find out whether there are more.
+//
+// This consumes one API request.
+func (s *Session) GetTracksByType(t TrackType, limit, offset int) ([]Track, error) {
+	page, err := s.GetTracksByTypePage(t, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// SetTrackType sets the type of the track with the given ID.
+//
+// Returns ErrInvalidTrackType, without making a request, if the type isn't a known one.
+//
+// This consumes one API request.
+func (s *Session) SetTrackType(trackid TrackID, t TrackType) error {
+	if !t.Valid() {
+		return ErrInvalidTrackType
+	}
+	params := url.Values{"type": []string{string(t)}}
+	_, err := s.apiRequestWithParams("PUT", fmt.Sprintf("/track/%d/type", trackid), nil, params)
+	return err
+}
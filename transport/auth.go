@@ -0,0 +1,43 @@
+package transport
+
+import "net/http"
+
+// TokenSource supplies the bearer tokens a Client authenticates with, in
+// place of (or as well as) an API key.
+//
+// Token is called before every request, so implementations should cache
+// tokens and only refresh them when they are about to expire.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// StaticToken is a TokenSource that always returns the same token, for
+// tokens that never expire or that are only used briefly.
+type StaticToken string
+
+// Token returns the token.
+func (t StaticToken) Token() (string, error) {
+	return string(t), nil
+}
+
+// TokenSourceFunc is a TokenSource that calls the function it wraps.
+type TokenSourceFunc func() (string, error)
+
+// Token calls f.
+func (f TokenSourceFunc) Token() (string, error) {
+	return f()
+}
+
+// setAuthorization sets the Authorization header from the Client's
+// TokenSource, if it has one.
+func (c *Client) setAuthorization(h http.Header) error {
+	if c.TokenSource == nil {
+		return nil
+	}
+	token, err := c.TokenSource.Token()
+	if err != nil {
+		return err
+	}
+	h.Set("Authorization", "Bearer "+token)
+	return nil
+}
@@ -0,0 +1,312 @@
+// Package transport sends requests to the MyRadio API, and decodes its responses.
+//
+// It handles the parts of talking to MyRadio that don't depend on what is
+// being asked for: authentication, failing over to mirrors, retrying during
+// maintenance, debug dumps and error responses.
+// Most programs should use the myradio package, which wraps a Client.
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Client sends requests to the MyRadio API.
+//
+// Its settings should not be changed while it is in use, except through
+// SetDebugWriter.
+type Client struct {
+	// APIKey is the API key sent with every request, unless it is empty.
+	APIKey string
+	// TokenSource, if not nil, supplies a bearer token sent in the
+	// Authorization header of every request, so that requests act with the
+	// permissions of the user the token was issued to.
+	TokenSource TokenSource
+	// BaseURL is the URL of the API, for example "https://ury.york.ac.uk/api/v2".
+	BaseURL url.URL
+	// HTTPClient sends the requests.
+	HTTPClient *http.Client
+	// Mirrors are tried, in order, when BaseURL is unavailable.
+	Mirrors []url.URL
+	// UserAgent is sent in the User-Agent header of every request.
+	UserAgent string
+	// Header holds extra headers sent with every request, such as trace IDs.
+	Header http.Header
+	// RequestID, if not nil, generates an ID for each request, sent in the
+	// RequestIDHeader unless Header already sets it.
+	// Retries and failovers of a request keep its ID.
+	RequestID func() string
+
+	// MaintenanceRetries is how many times GET requests are retried when
+	// MyRadio is in maintenance mode.
+	MaintenanceRetries int
+	// MaintenanceMaxWait is the longest the Client waits between those retries.
+	// If it is not positive, requests are not retried.
+	MaintenanceMaxWait time.Duration
+
+	health *healthTracker
+
+	debugMu sync.Mutex
+	debug   io.Writer
+}
+
+// NewClient creates a Client sending requests with the given API key to the
+// API at the given URL.
+func NewClient(apikey string, baseurl url.URL) *Client {
+	return &Client{
+		APIKey:     apikey,
+		BaseURL:    baseurl,
+		HTTPClient: &http.Client{},
+		health:     newHealthTracker(),
+	}
+}
+
+// Clone creates a copy of the Client.
+//
+// The copy shares the original's HTTP transport, and so its connection
+// pool, as well as its record of which mirrors are unavailable.
+// Changing settings on one Client does not affect the other.
+func (c *Client) Clone() *Client {
+	httpClient := *c.HTTPClient
+	c.debugMu.Lock()
+	debug := c.debug
+	c.debugMu.Unlock()
+
+	return &Client{
+		APIKey:             c.APIKey,
+		TokenSource:        c.TokenSource,
+		BaseURL:            c.BaseURL,
+		HTTPClient:         &httpClient,
+		Mirrors:            append([]url.URL(nil), c.Mirrors...),
+		UserAgent:          c.UserAgent,
+		Header:             c.Header.Clone(),
+		RequestID:          c.RequestID,
+		MaintenanceRetries: c.MaintenanceRetries,
+		MaintenanceMaxWait: c.MaintenanceMaxWait,
+		health:             c.health,
+		debug:              debug,
+	}
+}
+
+type response struct {
+	Status  string
+	Payload *json.RawMessage
+}
+
+// Call describes a single request to the API.
+type Call struct {
+	Method   string
+	Endpoint string
+	Mixins   []string
+	// Params are sent in the query string for GET and DELETE requests, and
+	// as a form-encoded body otherwise, unless Body is set.
+	Params url.Values
+	// Body, if not nil, is sent as the request body with the given content type.
+	// Params are then sent in the query string.
+	Body        []byte
+	ContentType string
+	// Into, if not nil, is where the payload is decoded to, avoiding the
+	// copy into a json.RawMessage; no payload is then returned.
+	Into interface{}
+}
+
+// Do performs an API call, retrying GET requests during maintenance if the
+// Client is set to do so.
+func (c *Client) Do(call Call) (*json.RawMessage, error) {
+	header, err := c.header()
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 0; ; attempt++ {
+		data, err := c.doOnce(call, header)
+		var merr *MaintenanceError
+		if call.Method != "GET" || attempt >= c.MaintenanceRetries || c.MaintenanceMaxWait <= 0 || !errors.As(err, &merr) {
+			return data, err
+		}
+		time.Sleep(c.maintenanceWait(merr))
+	}
+}
+
+// header returns the headers to send with a call, other than those
+// describing its body.
+func (c *Client) header() (http.Header, error) {
+	h := c.Header.Clone()
+	if h == nil {
+		h = make(http.Header)
+	}
+	if c.RequestID != nil && h.Get(RequestIDHeader) == "" {
+		h.Set(RequestIDHeader, c.RequestID())
+	}
+	h.Set("User-Agent", c.UserAgent)
+	if err := c.setAuthorization(h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// doOnce performs a single attempt at an API call, failing over to any
+// mirrors if the base URL it tries first is unavailable.
+func (c *Client) doOnce(call Call, header http.Header) (*json.RawMessage, error) {
+	bases := c.candidates()
+	for i, base := range bases {
+		res, err := c.send(base, call, header)
+		failed := shouldFailover(call.Method, res, err)
+		// A write that fails without a response may or may not have reached
+		// the server, so says nothing about its health either way.
+		if failed {
+			c.health.markFailed(base)
+		} else if err == nil {
+			c.health.markOK(base)
+		}
+		if failed && i < len(bases)-1 {
+			if res != nil {
+				res.Body.Close()
+			}
+			c.debugf("! %s unavailable, failing over", base.String())
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return c.decodeResponse(call, res)
+	}
+	panic("unreachable")
+}
+
+// send sends an API call to the API at the given base URL, with the given headers.
+func (c *Client) send(base url.URL, call Call, header http.Header) (*http.Response, error) {
+	theurl := base
+	query := url.Values{"mixins": call.Mixins}
+	if c.APIKey != "" {
+		query.Set("api_key", c.APIKey)
+	}
+	body, contentType := call.Body, call.ContentType
+	var form url.Values
+	if call.Method == "GET" || call.Method == "DELETE" || body != nil {
+		for k, v := range call.Params {
+			query[k] = v
+		}
+	} else if call.Params != nil {
+		form = call.Params
+		body, contentType = []byte(form.Encode()), "application/x-www-form-urlencoded"
+	}
+	theurl.Path += call.Endpoint
+	theurl.RawQuery = query.Encode()
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(call.Method, theurl.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.debugging() {
+		dumpurl := theurl
+		dumpurl.RawQuery = redactParams(query).Encode()
+		line := fmt.Sprintf("> %s %s", call.Method, dumpurl.String())
+		if id := header.Get(RequestIDHeader); id != "" {
+			line += fmt.Sprintf(" (%s: %s)", RequestIDHeader, id)
+		}
+		switch {
+		case call.Body != nil && isJSON(call.ContentType):
+			c.debugf("%s\n%s", line, c.redactBody(call.Body))
+		case call.Body != nil:
+			// Other bodies, such as uploads, are binary.
+			c.debugf("%s\n<%d bytes of %s>", line, len(call.Body), call.ContentType)
+		default:
+			c.debugf("%s\n%s", line, redactParams(form).Encode())
+		}
+	}
+	return c.HTTPClient.Do(req)
+}
+
+// bufferPool holds buffers for reading response bodies into.
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// maxPooledBuffer is the largest buffer kept in bufferPool, so that one huge
+// response doesn't pin its memory forever.
+const maxPooledBuffer = 1 << 20
+
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBuffer {
+		bufferPool.Put(buf)
+	}
+}
+
+// decodeResponse reads and decodes the response to an API call, closing its body.
+func (c *Client) decodeResponse(call Call, res *http.Response) (*json.RawMessage, error) {
+	defer res.Body.Close()
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer putBuffer(buf)
+	if _, err := buf.ReadFrom(res.Body); err != nil {
+		return nil, err
+	}
+	data := buf.Bytes()
+	if c.debugging() {
+		c.debugf("< HTTP %d %s\n%s", res.StatusCode, call.Endpoint, c.redactBody(data))
+	}
+
+	if call.Into != nil && res.StatusCode == 200 {
+		resJson := struct {
+			Status  string
+			Payload interface{}
+		}{Payload: call.Into}
+		// If this fails, the payload may be an error message instead, so
+		// fall through to decoding it generically.
+		if json.Unmarshal(data, &resJson) == nil && resJson.Status == "OK" {
+			return nil, nil
+		}
+	}
+
+	var resJson response
+	jsonErr := json.Unmarshal(data, &resJson)
+	if isMaintenance(res.StatusCode, data) {
+		return nil, &MaintenanceError{
+			APIError:   newAPIError(call.Endpoint, res.StatusCode, resJson),
+			RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+		}
+	}
+	if res.StatusCode != 200 {
+		return nil, newAPIError(call.Endpoint, res.StatusCode, resJson)
+	}
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+	if resJson.Status != "OK" {
+		return nil, newAPIError(call.Endpoint, res.StatusCode, resJson)
+	}
+	if call.Into != nil {
+		// The payload was OK, but didn't fit into call.Into.
+		return nil, json.Unmarshal(*resJson.Payload, call.Into)
+	}
+	return resJson.Payload, nil
+}
+
+// newAPIError creates an APIError from a (possibly empty) error response.
+func newAPIError(endpoint string, code int, res response) *APIError {
+	err := &APIError{
+		Endpoint:   endpoint,
+		StatusCode: code,
+		Status:     res.Status,
+	}
+	if res.Payload != nil {
+		err.parsePayload(*res.Payload)
+	}
+	return err
+}
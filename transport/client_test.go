@@ -0,0 +1,75 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// currentAndNext is enough of the now playing payload to decode realistically.
+type currentAndNext struct {
+	Next    map[string]interface{} `json:"next"`
+	Current map[string]interface{} `json:"current"`
+}
+
+// benchResponse returns a response with the given body.
+func benchResponse(body []byte) *http.Response {
+	return &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+	}
+}
+
+// BenchmarkDecodeCurrentAndNext compares ways of decoding a response from a
+// frequently polled endpoint.
+func BenchmarkDecodeCurrentAndNext(b *testing.B) {
+	c := NewClient("TEST-KEY", url.URL{})
+	body, err := ioutil.ReadFile("../testdata/currentandnext.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	call := Call{Method: "GET", Endpoint: "/timeslot/currentandnext"}
+
+	// ReadAll is how responses were decoded before buffers were pooled.
+	b.Run("ReadAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			res := benchResponse(body)
+			data, _ := ioutil.ReadAll(res.Body)
+			var resJson response
+			if err := json.Unmarshal(data, &resJson); err != nil {
+				b.Fatal(err)
+			}
+			var currentAndNext currentAndNext
+			if err := json.Unmarshal(*resJson.Payload, &currentAndNext); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			data, err := c.decodeResponse(call, benchResponse(body))
+			if err != nil {
+				b.Fatal(err)
+			}
+			var currentAndNext currentAndNext
+			if err := json.Unmarshal(*data, &currentAndNext); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Into", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var currentAndNext currentAndNext
+			call.Into = &currentAndNext
+			if _, err := c.decodeResponse(call, benchResponse(body)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
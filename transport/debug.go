@@ -0,0 +1,74 @@
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+)
+
+// redacted replaces the API key in debug output.
+const redacted = "REDACTED"
+
+// SetDebugWriter makes the Client dump every request and response to w.
+//
+// The API key is redacted from the dumps.
+// Passing nil turns dumping off again.
+// This may be called at any time, including while requests are in progress.
+func (c *Client) SetDebugWriter(w io.Writer) {
+	c.debugMu.Lock()
+	c.debug = w
+	c.debugMu.Unlock()
+}
+
+// debugging returns true if the Client has a debug writer set.
+func (c *Client) debugging() bool {
+	c.debugMu.Lock()
+	defer c.debugMu.Unlock()
+	return c.debug != nil
+}
+
+// debugf writes a formatted dump to the debug writer, if there is one.
+//
+// Each dump is written in one go, so dumps from concurrent requests don't interleave.
+func (c *Client) debugf(format string, args ...interface{}) {
+	c.debugMu.Lock()
+	defer c.debugMu.Unlock()
+	if c.debug == nil {
+		return
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, format, args...)
+	if buf.Len() > 0 && buf.Bytes()[buf.Len()-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+	c.debug.Write(buf.Bytes())
+}
+
+// redactParams returns a copy of params with the API key redacted.
+func redactParams(params url.Values) url.Values {
+	r := make(url.Values, len(params))
+	for k, v := range params {
+		r[k] = v
+	}
+	if _, ok := r["api_key"]; ok {
+		r["api_key"] = []string{redacted}
+	}
+	return r
+}
+
+// redactBody returns a copy of data with any occurrences of the API key redacted.
+func (c *Client) redactBody(data []byte) []byte {
+	if c.APIKey == "" {
+		return data
+	}
+	return bytes.Replace(data, []byte(c.APIKey), []byte(redacted), -1)
+}
+
+// isJSON returns true if contentType is that of a JSON body, which is dumped
+// in full rather than summarised.
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
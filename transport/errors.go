@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// APIError is the error returned when MyRadio responds to a request with an error.
+type APIError struct {
+	// Endpoint is the API endpoint that was requested.
+	Endpoint string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Status is the status given in the response body, if any.
+	Status string
+	// Message is the error message given in the response body, if any.
+	Message string
+	// Fields maps the names of any invalid request fields to why they were invalid.
+	Fields map[string]string
+	// RequiredPermission is the permission the caller lacked, if that is why
+	// the request failed.
+	RequiredPermission string
+}
+
+// errorPayload is the structured form of an error response payload.
+type errorPayload struct {
+	Message    string            `json:"message"`
+	Fields     map[string]string `json:"fields"`
+	Permission json.RawMessage   `json:"permission"`
+}
+
+// parsePayload fills in the details of the APIError from an error response payload.
+func (e *APIError) parsePayload(payload json.RawMessage) {
+	// The payload of an error is usually, but not always, a message string.
+	if json.Unmarshal(payload, &e.Message) == nil {
+		return
+	}
+	var details errorPayload
+	if json.Unmarshal(payload, &details) != nil || (details.Message == "" && details.Fields == nil && details.Permission == nil) {
+		e.Message = string(payload)
+		return
+	}
+	e.Message = details.Message
+	e.Fields = details.Fields
+	// Permissions are given by either their constant name or their ID.
+	if json.Unmarshal(details.Permission, &e.RequiredPermission) != nil {
+		e.RequiredPermission = string(details.Permission)
+	}
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s Not ok: HTTP %d", e.Endpoint, e.StatusCode)
+	if e.Status != "" {
+		msg += " " + e.Status
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// IsNotFound returns true if err is an APIError for a missing object.
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && apiErr.StatusCode == 404
+}
+
+// IsPermissionDenied returns true if err is an APIError for a request the caller
+// isn't permitted to make.
+func IsPermissionDenied(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && (apiErr.StatusCode == 403 || apiErr.RequiredPermission != "")
+}
@@ -0,0 +1,47 @@
+package transport
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestAPIErrorParsePayload(t *testing.T) {
+	tests := []struct {
+		payload  string
+		expected APIError
+	}{
+		{`"Not now"`, APIError{Message: "Not now"}},
+		{`[1, 2]`, APIError{Message: "[1, 2]"}},
+		{`{"unexpected": true}`, APIError{Message: `{"unexpected": true}`}},
+		{
+			`{"message": "Invalid show", "fields": {"title": "Required", "genre": "Unknown genre"}}`,
+			APIError{Message: "Invalid show", Fields: map[string]string{"title": "Required", "genre": "Unknown genre"}},
+		},
+		{
+			`{"message": "Caller cannot access this method", "permission": "AUTH_EDITSHOWS"}`,
+			APIError{Message: "Caller cannot access this method", RequiredPermission: "AUTH_EDITSHOWS"},
+		},
+		{`{"message": "Denied", "permission": 207}`, APIError{Message: "Denied", RequiredPermission: "207"}},
+	}
+
+	for _, test := range tests {
+		var got APIError
+		got.parsePayload(json.RawMessage(test.payload))
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Error("Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
+
+func TestIsPermissionDenied(t *testing.T) {
+	if !IsPermissionDenied(&APIError{StatusCode: 403}) {
+		t.Error("Expected HTTP 403 to be a permission error")
+	}
+	if !IsPermissionDenied(&APIError{StatusCode: 400, RequiredPermission: "AUTH_EDITSHOWS"}) {
+		t.Error("Expected a required permission to be a permission error")
+	}
+	if IsPermissionDenied(&APIError{StatusCode: 404}) {
+		t.Error("Expected HTTP 404 not to be a permission error")
+	}
+}
@@ -0,0 +1,99 @@
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// failoverCooldown is how long a base URL is passed over for after it fails.
+const failoverCooldown = 30 * time.Second
+
+// healthTracker records which base URLs have recently been unavailable.
+//
+// It is shared between a Client and its clones.
+type healthTracker struct {
+	mu          sync.Mutex
+	failedUntil map[string]time.Time
+}
+
+func newHealthTracker() *healthTracker {
+	return &healthTracker{failedUntil: make(map[string]time.Time)}
+}
+
+// healthy returns true if u hasn't failed within the cooldown period.
+func (h *healthTracker) healthy(u url.URL) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return time.Now().After(h.failedUntil[u.String()])
+}
+
+// markFailed records that u has just been unavailable.
+func (h *healthTracker) markFailed(u url.URL) {
+	h.mu.Lock()
+	h.failedUntil[u.String()] = time.Now().Add(failoverCooldown)
+	h.mu.Unlock()
+}
+
+// markOK records that u has just responded.
+func (h *healthTracker) markOK(u url.URL) {
+	h.mu.Lock()
+	delete(h.failedUntil, u.String())
+	h.mu.Unlock()
+}
+
+// candidates returns the base URLs to try for a request, in order.
+//
+// URLs believed to be healthy come first, in their configured order,
+// followed by those that have recently failed.
+// Any URL that fails is passed over for a while in favour of the next one,
+// but is still tried as a last resort if everything else has failed too.
+func (c *Client) candidates() []url.URL {
+	all := append([]url.URL{c.BaseURL}, c.Mirrors...)
+	candidates := make([]url.URL, 0, len(all))
+	var failed []url.URL
+	for _, u := range all {
+		if c.health.healthy(u) {
+			candidates = append(candidates, u)
+		} else {
+			failed = append(failed, u)
+		}
+	}
+	return append(candidates, failed...)
+}
+
+// shouldFailover decides whether the result of sending a request means the
+// server was unavailable, and another should be tried.
+//
+// Requests that could change data are only failed over if they never
+// reached the server, so that they aren't applied twice.
+// A 503 means the server is unavailable unless it is MyRadio itself saying
+// it is in maintenance, which every mirror would say too; to tell, the body
+// of a 503 is read, and replaced so that it can be read again.
+func shouldFailover(method string, res *http.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+		return method == "GET"
+	}
+	if method != "GET" {
+		return false
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return true
+	case http.StatusServiceUnavailable:
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		return err != nil || !isMaintenance(res.StatusCode, body)
+	}
+	return false
+}
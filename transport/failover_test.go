@@ -0,0 +1,35 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestFailoverWriteTimeout(t *testing.T) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer srv.Close()
+	defer close(done)
+	base, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewClient("TEST-KEY", *base)
+	c.HTTPClient.Timeout = 10 * time.Millisecond
+	c.health.markFailed(*base)
+
+	if _, err := c.Do(Call{Method: "POST", Endpoint: "/track/1/loudness"}); err == nil {
+		t.Error("Expected the write to time out")
+	}
+	// The timeout doesn't show the server is back, so it should still be
+	// passed over.
+	if c.health.healthy(*base) {
+		t.Error("Got: healthy after a timed out write, Expected: still failed")
+	}
+}
@@ -0,0 +1,81 @@
+package transport
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrMaintenance matches (with errors.Is) any error caused by MyRadio being
+// down for maintenance or in read-only mode.
+//
+// Use errors.As with a *MaintenanceError to find out when to retry.
+var ErrMaintenance = errors.New("MyRadio is in maintenance mode")
+
+// defaultMaintenanceWait is how long to wait before retrying if MyRadio doesn't say.
+const defaultMaintenanceWait = 30 * time.Second
+
+// MaintenanceError is the error returned when MyRadio is down for maintenance
+// or in read-only mode.
+type MaintenanceError struct {
+	*APIError
+	// RetryAfter is MyRadio's estimate of how long the maintenance will last,
+	// or zero if it gave none.
+	RetryAfter time.Duration
+}
+
+func (e *MaintenanceError) Is(target error) bool {
+	return target == ErrMaintenance
+}
+
+func (e *MaintenanceError) Unwrap() error {
+	return e.APIError
+}
+
+// isMaintenance checks whether a response, with the given status code and
+// body, means MyRadio is in maintenance mode.
+//
+// MyRadio signals this with HTTP 503 and one of its usual JSON responses,
+// whether it is fully down or read-only.
+// A 503 without one comes from something in front of MyRadio, such as a
+// proxy whose backend is down, and isn't maintenance.
+func isMaintenance(code int, body []byte) bool {
+	if code != http.StatusServiceUnavailable {
+		return false
+	}
+	var res response
+	return json.Unmarshal(body, &res) == nil && res.Status != ""
+}
+
+// parseRetryAfter parses a Retry-After header, which may be either a number
+// of seconds or a date.
+//
+// Returns zero if the header is missing or ill-formed.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(header); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// maintenanceWait works out how long to wait before retrying after err.
+//
+// It is never more than MaintenanceMaxWait, which must be positive.
+func (c *Client) maintenanceWait(err *MaintenanceError) time.Duration {
+	wait := err.RetryAfter
+	if wait == 0 {
+		wait = defaultMaintenanceWait
+	}
+	if wait > c.MaintenanceMaxWait {
+		wait = c.MaintenanceMaxWait
+	}
+	return wait
+}
@@ -0,0 +1,48 @@
+package transport
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2016, 5, 11, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		header   string
+		expected time.Duration
+	}{
+		{"", 0},
+		{"120", 2 * time.Minute},
+		{"-5", 0},
+		{"Wed, 11 May 2016 12:05:00 GMT", 5 * time.Minute},
+		{"Wed, 11 May 2016 11:55:00 GMT", 0},
+		{"soon", 0},
+	}
+
+	for _, test := range tests {
+		got := parseRetryAfter(test.header, now)
+		if got != test.expected {
+			t.Error("Header:", test.header, ", Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
+
+func TestIsMaintenance(t *testing.T) {
+	tests := []struct {
+		code     int
+		body     string
+		expected bool
+	}{
+		{503, `{"status":"FAIL","payload":"MyRadio is read-only for an upgrade"}`, true},
+		{503, "<html><body><h1>503 Service Unavailable</h1></body></html>", false},
+		{503, "", false},
+		{503, `{"error":"upstream down"}`, false},
+		{500, `{"status":"FAIL","payload":"Oops"}`, false},
+	}
+
+	for _, test := range tests {
+		if got := isMaintenance(test.code, []byte(test.body)); got != test.expected {
+			t.Error("Code:", test.code, ", Body:", test.body, ", Got:", got, ", Expected:", test.expected)
+		}
+	}
+}
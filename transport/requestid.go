@@ -0,0 +1,19 @@
+package transport
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIDHeader is the header request IDs are sent in.
+const RequestIDHeader = "X-Request-ID"
+
+// NewRequestID generates a random request ID, as 32 hex digits.
+func NewRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// crypto/rand never fails on supported platforms.
+		panic(err)
+	}
+	return hex.EncodeToString(b[:])
+}
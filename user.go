@@ -5,28 +5,55 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/users"
 )
 
-type Officership struct {
-	OfficerId   uint   `json:"officerid,string"`
-	OfficerName string `json:"officer_name"`
-	TeamId      uint   `json:"teamid,string"`
-	FromDateRaw string `json:"from_date,omitempty"`
-	FromDate    time.Time
-	TillDateRaw string `json:"till_date,omitempty"`
-	TillDate    time.Time
+// Officership is an officer position a member holds or held.
+type Officership = users.Officership
+
+// Photo is a photo a member has uploaded.
+type Photo = users.Photo
+
+// ResolvePhotoOwners fills in the OwnerMember of each of the given photos.
+//
+// Owners are looked up in parallel, at most MaxParallelLookups at a time,
+// and each distinct owner consumes one API request.
+// If any lookup fails, one of the errors is returned, and the photos whose
+// owners could not be looked up are left unresolved.
+func (s *Session) ResolvePhotoOwners(photos []Photo) error {
+	ids := make([]UserID, len(photos))
+	for k, p := range photos {
+		ids[k] = p.Owner
+	}
+	owners, err := lookupMembers(ids, s.GetMember)
+
+	for k, p := range photos {
+		if owner := owners[p.Owner]; owner != nil {
+			photos[k].OwnerMember = owner
+		}
+	}
+	return err
+}
+
+// PhotoOption is a setting that changes how photos are fetched.
+type PhotoOption func(*photoOptions)
+
+// photoOptions are the settings applied by PhotoOptions.
+type photoOptions struct {
+	resolveOwners bool
 }
 
-type Photo struct {
-	PhotoId      uint   `json:"photoid"`
-	DateAddedRaw string `json:"date_added"`
-	DateAdded    time.Time
-	Format       string `json:"format"`
-	Owner        uint   `json:"owner"`
-	Url          string `json:"url"`
+// WithPhotoOwners makes photo lookups resolve the OwnerMember of each photo,
+// as ResolvePhotoOwners does, so that photo credits can be shown without
+// further lookups.
+func WithPhotoOwners() PhotoOption {
+	return func(o *photoOptions) {
+		o.resolveOwners = true
+	}
 }
 
-func (s *Session) GetUserBio(id int) (bio string, err error) {
+func (s *Session) GetUserBio(id UserID) (bio string, err error) {
 	data, err := s.apiRequest(fmt.Sprintf("/user/%d/bio/", id), []string{})
 	if err != nil {
 		return
@@ -39,7 +66,7 @@ func (s *Session) GetUserBio(id int) (bio string, err error) {
 	return
 }
 
-func (s *Session) GetUserName(id int) (name string, err error) {
+func (s *Session) GetUserName(id UserID) (name string, err error) {
 	data, err := s.apiRequest(fmt.Sprintf("/user/%d/name/", id), []string{})
 	if err != nil {
 		return
@@ -48,7 +75,7 @@ func (s *Session) GetUserName(id int) (name string, err error) {
 	return
 }
 
-func (s *Session) GetUserProfilePhoto(id int) (profilephoto Photo, err error) {
+func (s *Session) GetUserProfilePhoto(id UserID) (profilephoto Photo, err error) {
 	data, err := s.apiRequest(fmt.Sprintf("/user/%d/profilephoto/", id), []string{})
 	if err != nil {
 		return
@@ -61,11 +88,55 @@ func (s *Session) GetUserProfilePhoto(id int) (profilephoto Photo, err error) {
 	if err != nil {
 		return
 	}
-	profilephoto.DateAdded, err = time.Parse("02/01/2006 15:04", profilephoto.DateAddedRaw)
+	profilephoto.DateAdded, err = time.Parse(dateTimeLayout, profilephoto.DateAddedRaw)
 	return
 }
 
-func (s *Session) GetUserOfficerships(id int) (officerships []Officership, err error) {
+// GetUserPhotosPage gets a page of up to limit photos the user with the
+// given ID has uploaded, oldest first, skipping the first offset.
+//
+// A limit of 0 means no limit.
+//
+// This consumes one API request, plus one for each distinct owner if
+// WithPhotoOwners is given.
+func (s *Session) GetUserPhotosPage(id UserID, limit, offset int, opts ...PhotoOption) (*Page[Photo], error) {
+	var o photoOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	page, err := getPage[Photo](s, fmt.Sprintf("/user/%d/allphotos/", id), []string{}, limit, offset, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range page.Items {
+		page.Items[k].DateAdded, err = time.Parse(dateTimeLayout, v.DateAddedRaw)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if o.resolveOwners {
+		// As with GetUserPhotos, the page is returned even if some owners
+		// could not be looked up.
+		err = s.ResolvePhotoOwners(page.Items)
+	}
+	return page, err
+}
+
+// GetUserPhotos gets every photo the user with the given ID has uploaded,
+// including ones no longer used as their profile photo, oldest first.
+//
+// This consumes one API request, plus one for each distinct owner if
+// WithPhotoOwners is given.
+func (s *Session) GetUserPhotos(id UserID, opts ...PhotoOption) ([]Photo, error) {
+	page, err := s.GetUserPhotosPage(id, 0, 0, opts...)
+	if page == nil {
+		return nil, err
+	}
+	return page.Items, err
+}
+
+func (s *Session) GetUserOfficerships(id UserID) (officerships []Officership, err error) {
 	data, err := s.apiRequest(fmt.Sprintf("/user/%d/officerships/", id), []string{})
 	if err != nil {
 		return
@@ -76,13 +147,13 @@ func (s *Session) GetUserOfficerships(id int) (officerships []Officership, err e
 	}
 	for k, v := range officerships {
 		if officerships[k].FromDateRaw != "" {
-			officerships[k].FromDate, err = time.Parse("2006-01-02", v.FromDateRaw)
+			officerships[k].FromDate, err = time.Parse(dateLayout, v.FromDateRaw)
 			if err != nil {
 				return
 			}
 		}
 		if officerships[k].TillDateRaw != "" {
-			officerships[k].TillDate, err = time.Parse("2006-01-02", v.FromDateRaw)
+			officerships[k].TillDate, err = time.Parse(dateLayout, v.TillDateRaw)
 			if err != nil {
 				return
 			}
@@ -91,7 +162,7 @@ func (s *Session) GetUserOfficerships(id int) (officerships []Officership, err e
 	return
 }
 
-func (s *Session) GetUserShowCredits(id int) (shows []ShowMeta, err error) {
+func (s *Session) GetUserShowCredits(id UserID) (shows []ShowMeta, err error) {
 	data, err := s.apiRequest(fmt.Sprintf("/user/%d/shows/", id), []string{})
 	if err != nil {
 		return
@@ -0,0 +1,130 @@
+package myradio
+
+import (
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestResolvePhotoOwners(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/user/7449":
+			writePayload(w, Member{Memberid: 7449, Fname: "Jane", Sname: "Bloggs"})
+		case "/user/1234":
+			writePayload(w, Member{Memberid: 1234, Fname: "Joe", Sname: "Bloggs"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	photos := []Photo{{PhotoId: 880, Owner: 7449}, {PhotoId: 1042, Owner: 7449}, {PhotoId: 1200, Owner: 1234}}
+	if err := s.ResolvePhotoOwners(photos); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range photos {
+		owner, err := p.GetOwner(s)
+		if err != nil || owner.Memberid != p.Owner {
+			t.Error("Got:", owner, ", Error:", err, ", Expected owner:", p.Owner)
+		}
+	}
+	if requests["/user/7449"] != 1 || requests["/user/1234"] != 1 {
+		t.Error("Got:", requests, ", Expected: one request per owner")
+	}
+
+	unresolved := Photo{PhotoId: 1300, Owner: 1234}
+	if owner, err := unresolved.GetOwner(s); err != nil || owner.Fname != "Joe" || requests["/user/1234"] != 2 {
+		t.Error("Got:", owner, ", Error:", err)
+	}
+	partial := []Photo{{Owner: 404}, {Owner: 7449}}
+	if err := s.ResolvePhotoOwners(partial); !IsNotFound(err) {
+		t.Error("Got:", err, ", Expected: not found")
+	}
+	if partial[0].OwnerMember != nil || partial[1].OwnerMember == nil {
+		t.Error("Got owners:", partial[0].OwnerMember, partial[1].OwnerMember, ", Expected only the second")
+	}
+}
+
+func TestGetUserPhotosWithOwners(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/allphotos.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.Path)
+		mu.Unlock()
+		if r.URL.Path == "/user/7449/allphotos/" {
+			w.Write(fixture)
+			return
+		}
+		writePayload(w, Member{Memberid: 7449, Fname: "Jane", Sname: "Bloggs"})
+	}))
+
+	photos, err := s.GetUserPhotos(7449)
+	if err != nil || len(photos) == 0 || photos[0].OwnerMember != nil || len(requests) != 1 {
+		t.Error("Got:", photos, "in", requests, ", Error:", err, ", Expected unresolved owners")
+	}
+
+	requests = nil
+	photos, err = s.GetUserPhotos(7449, WithPhotoOwners())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range photos {
+		if p.OwnerMember == nil || p.OwnerMember.Fname != "Jane" {
+			t.Error("Got owner:", p.OwnerMember, ", Expected: Jane")
+		}
+	}
+	if len(requests) != 2 || requests[1] != "/user/7449" {
+		t.Error("Got:", requests, ", Expected: the photos, then one lookup of the owner")
+	}
+}
+
+func TestUserPreferences(t *testing.T) {
+	prefs := map[string]string{"theme": "dark"}
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/user/currentuser/preferences":
+			writePayload(w, prefs)
+		case r.Method == "PUT":
+			prefs[r.FormValue("key")] = r.FormValue("value")
+			writePayload(w, nil)
+		default:
+			value, ok := prefs[r.FormValue("key")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"status":"FAIL","payload":"No such preference"}`))
+				return
+			}
+			writePayload(w, value)
+		}
+	}))
+
+	if value, err := s.GetUserPreference("theme"); err != nil || value != "dark" {
+		t.Error("Got:", value, ", Error:", err)
+	}
+	if _, err := s.GetUserPreference("font"); err != ErrNoPreference {
+		t.Error("Got:", err, ", Expected:", ErrNoPreference)
+	}
+	if err := s.SetUserPreference("font", "large & clear"); err != nil {
+		t.Fatal(err)
+	}
+	all, err := s.GetUserPreferences()
+	if err != nil || len(all) != 2 || all["font"] != "large & clear" {
+		t.Error("Got:", all, ", Error:", err)
+	}
+	if err = s.SetUserPreference("", "x"); err == nil {
+		t.Error("Expected an error for an empty key")
+	}
+}
@@ -0,0 +1,45 @@
+package users
+
+import "strconv"
+
+// UserID is the ID of a MyRadio user, that is, of a Member.
+type UserID int
+
+// OfficerID is the ID of an Officer position.
+type OfficerID uint
+
+// TeamID is the ID of a Team of officers.
+type TeamID uint
+
+// String returns the ID in decimal.
+func (id UserID) String() string {
+	return strconv.Itoa(int(id))
+}
+
+// String returns the ID in decimal.
+func (id OfficerID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// String returns the ID in decimal.
+func (id TeamID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// ParseUserID parses a UserID written in decimal, as in a URL.
+func ParseUserID(s string) (UserID, error) {
+	id, err := strconv.Atoi(s)
+	return UserID(id), err
+}
+
+// ParseOfficerID parses an OfficerID written in decimal, as in a URL.
+func ParseOfficerID(s string) (OfficerID, error) {
+	id, err := strconv.ParseUint(s, 10, 0)
+	return OfficerID(id), err
+}
+
+// ParseTeamID parses a TeamID written in decimal, as in a URL.
+func ParseTeamID(s string) (TeamID, error) {
+	id, err := strconv.ParseUint(s, 10, 0)
+	return TeamID(id), err
+}
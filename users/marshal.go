@@ -0,0 +1,30 @@
+package users
+
+import (
+	"encoding/json"
+
+	"github.com/UniversityRadioYork/myradio-go/internal/timefmt"
+)
+
+// The MarshalJSON methods in this file encode types back into the same form
+// the API uses, as the ones in the myradio package do.
+
+func (o Officership) MarshalJSON() ([]byte, error) {
+	type officership Officership
+	o.FromDateRaw = timefmt.Format(o.FromDate, timefmt.Date, o.FromDateRaw)
+	o.TillDateRaw = timefmt.Format(o.TillDate, timefmt.Date, o.TillDateRaw)
+	return json.Marshal(officership(o))
+}
+
+func (p Photo) MarshalJSON() ([]byte, error) {
+	type photo Photo
+	p.DateAddedRaw = timefmt.Format(p.DateAdded, timefmt.DateTime, p.DateAddedRaw)
+	return json.Marshal(photo(p))
+}
+
+func (h OfficerHolder) MarshalJSON() ([]byte, error) {
+	type officerHolder OfficerHolder
+	h.FromDateRaw = timefmt.Format(h.FromDate, timefmt.Date, h.FromDateRaw)
+	h.TillDateRaw = timefmt.Format(h.TillDate, timefmt.Date, h.TillDateRaw)
+	return json.Marshal(officerHolder(h))
+}
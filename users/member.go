@@ -0,0 +1,19 @@
+// Package users holds the MyRadio types describing members: their profiles,
+// photos and officer positions.
+//
+// Most programs should use the myradio package, which aliases these types
+// and has the Session methods for fetching them.
+package users
+
+type Member struct {
+	Memberid     UserID
+	Fname, Sname string
+	Sex          string
+	Email        string `json:"public_email"`
+	Receiveemail bool   `json:"receive_email"`
+}
+
+// MemberGetter looks up members by ID; a *myradio.Session is one.
+type MemberGetter interface {
+	GetMember(id UserID) (*Member, error)
+}
@@ -0,0 +1,43 @@
+package users
+
+import (
+	"time"
+)
+
+// Team is a team of officers, such as Computing.
+type Team struct {
+	TeamID      TeamID `json:"teamid"`
+	Name        string `json:"name"`
+	Alias       string `json:"alias"`
+	Ordering    int    `json:"ordering"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+}
+
+// Officer is an officer position, which may or may not currently be filled.
+type Officer struct {
+	OfficerID   OfficerID `json:"officerid"`
+	Name        string    `json:"name"`
+	Alias       string    `json:"alias"`
+	Team        Team      `json:"team"`
+	Ordering    int       `json:"ordering"`
+	Description string    `json:"description"`
+	// Status is 'c' for a current position, and 'h' for a historical one.
+	Status string `json:"status"`
+	// Type is the kind of position, for example 'o' for an officer or 'a' for an assistant.
+	Type string `json:"type"`
+	// Current contains the members currently holding this position.
+	Current []Member `json:"current,omitempty"`
+}
+
+// OfficerHolder is a member's tenure in an officer position.
+type OfficerHolder struct {
+	MemberOfficerID uint      `json:"memberofficerid"`
+	User            Member    `json:"user"`
+	FromDateRaw     string    `json:"from"`
+	FromDate        time.Time `json:"-"`
+	TillDateRaw     string    `json:"till,omitempty"`
+	TillDate        time.Time `json:"-"`
+	// Officer is the position held; it is only set when listing a team's members.
+	Officer *Officer `json:"officer,omitempty"`
+}
@@ -0,0 +1,38 @@
+package users
+
+import (
+	"time"
+)
+
+type Officership struct {
+	OfficerId   OfficerID `json:"officerid,string"`
+	OfficerName string    `json:"officer_name"`
+	TeamId      TeamID    `json:"teamid,string"`
+	FromDateRaw string    `json:"from_date,omitempty"`
+	FromDate    time.Time `json:"-"`
+	TillDateRaw string    `json:"till_date,omitempty"`
+	TillDate    time.Time `json:"-"`
+}
+
+type Photo struct {
+	PhotoId      uint      `json:"photoid"`
+	DateAddedRaw string    `json:"date_added"`
+	DateAdded    time.Time `json:"-"`
+	Format       string    `json:"format"`
+	Owner        UserID    `json:"owner"`
+	Url          string    `json:"url"`
+	// OwnerMember is the member who owns the photo, if it has been resolved
+	// with Session.ResolvePhotoOwners.
+	OwnerMember *Member `json:"-"`
+}
+
+// GetOwner gets the member who owns the photo.
+//
+// This consumes one API request, unless the owner has already been resolved
+// with Session.ResolvePhotoOwners.
+func (p *Photo) GetOwner(g MemberGetter) (*Member, error) {
+	if p.OwnerMember != nil {
+		return p.OwnerMember, nil
+	}
+	return g.GetMember(p.Owner)
+}
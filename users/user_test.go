@@ -0,0 +1,28 @@
+package users
+
+import (
+	"testing"
+)
+
+// memberMap is a MemberGetter that looks members up in a map.
+type memberMap map[UserID]*Member
+
+func (m memberMap) GetMember(id UserID) (*Member, error) {
+	return m[id], nil
+}
+
+func TestGetOwner(t *testing.T) {
+	jane := &Member{Memberid: 7449, Fname: "Jane"}
+	members := memberMap{7449: jane}
+
+	photo := Photo{PhotoId: 880, Owner: 7449}
+	if owner, err := photo.GetOwner(members); err != nil || owner != jane {
+		t.Error("Got:", owner, ", Error:", err, ", Expected:", jane)
+	}
+
+	// A resolved owner is used without looking it up.
+	resolved := Photo{PhotoId: 881, Owner: 7449, OwnerMember: &Member{Memberid: 7449, Fname: "Cached"}}
+	if owner, err := resolved.GetOwner(memberMap{}); err != nil || owner.Fname != "Cached" {
+		t.Error("Got:", owner, ", Error:", err, ", Expected the resolved owner")
+	}
+}
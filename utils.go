@@ -1,6 +1,19 @@
 package myradio
 
-import "time"
+import (
+	"errors"
+	"time"
+
+	"github.com/UniversityRadioYork/myradio-go/internal/timefmt"
+)
+
+// Layouts of the times and durations in API responses.
+const (
+	dateLayout          = timefmt.Date
+	dateTimeLayout      = timefmt.DateTime
+	tracklistTimeLayout = timefmt.TracklistTime
+	durationLayout      = timefmt.Duration
+)
 
 // parseDuration takes a custom layout and a value and returns a time.Duration
 //
@@ -17,3 +30,37 @@ func parseDuration(layout, value string) (dur time.Duration, err error) {
 	}
 	return t.Sub(midnight), nil
 }
+
+// ErrPollTimeout is the error returned when something being waited on
+// doesn't happen in time.
+var ErrPollTimeout = errors.New("timed out waiting for MyRadio")
+
+// defaultWatchInterval is how often the Watch methods poll when given an
+// interval of zero or less.
+const defaultWatchInterval = time.Second
+
+// watchInterval returns the interval to poll at when asked for d.
+func watchInterval(d time.Duration) time.Duration {
+	if d <= 0 {
+		return defaultWatchInterval
+	}
+	return d
+}
+
+// poll calls check every interval until it returns true or an error, or until
+// timeout has passed, in which case it returns ErrPollTimeout.
+//
+// check is always called at least once, immediately.
+func poll(interval, timeout time.Duration, check func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return ErrPollTimeout
+		}
+		time.Sleep(interval)
+	}
+}
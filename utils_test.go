@@ -23,3 +23,21 @@ func TestParseDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestPoll(t *testing.T) {
+	calls := 0
+	err := poll(time.Millisecond, time.Second, func() (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	if err != nil || calls != 3 {
+		t.Error("Got:", calls, "calls, Expected: 3, Error:", err)
+	}
+
+	err = poll(time.Millisecond, 5*time.Millisecond, func() (bool, error) {
+		return false, nil
+	})
+	if err != ErrPollTimeout {
+		t.Error("Got:", err, ", Expected:", ErrPollTimeout)
+	}
+}
@@ -0,0 +1,8 @@
+package myradio
+
+// Version is the version of this library.
+const Version = "0.1.0"
+
+// DefaultUserAgent is the User-Agent sent with every request, unless
+// overridden with WithUserAgent.
+const DefaultUserAgent = "myradio-go/" + Version + " (+https://github.com/UniversityRadioYork/myradio-go)"
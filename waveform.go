@@ -0,0 +1,126 @@
+package myradio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/UniversityRadioYork/myradio-go/tracks"
+)
+
+// Waveform contains peak data for drawing a track's waveform.
+type Waveform = tracks.Waveform
+
+// ErrNoWaveform is returned by GetTrackWaveform when MyRadio has no waveform
+// for a track.
+var ErrNoWaveform = errors.New("No waveform available")
+
+// GetTrackWaveform tries to get the precomputed waveform of the track with the given ID.
+//
+// Returns ErrNoWaveform, or an APIError that IsNotFound, if MyRadio has no
+// waveform for the track; see WaveformCache for falling back to computing one.
+//
+// This consumes one API request.
+func (s *Session) GetTrackWaveform(trackid TrackID) (*Waveform, error) {
+	data, err := s.apiRequest(fmt.Sprintf("/track/%d/waveform", trackid), nil)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, ErrNoWaveform
+	}
+	waveform := new(Waveform)
+	err = json.Unmarshal(*data, waveform)
+	if err != nil {
+		return nil, err
+	}
+	return waveform, nil
+}
+
+// ComputeWaveform computes a Waveform from raw audio, read from pcm as
+// interleaved signed 16-bit little-endian samples at the given sample rate
+// and number of channels.
+//
+// Decoding the track's audio file to PCM is left to the caller.
+//
+// This consumes no API requests.
+func ComputeWaveform(pcm io.Reader, sampleRate, channels, peaksPerSecond int) (*Waveform, error) {
+	return tracks.ComputeWaveform(pcm, sampleRate, channels, peaksPerSecond)
+}
+
+// WaveformCache gets track waveforms, caching them, and computes them if
+// MyRadio doesn't have them.
+//
+// It is safe for concurrent use: simultaneous lookups of the same track
+// share a single API request (and computation).
+type WaveformCache struct {
+	session *Session
+	compute func(trackid TrackID) (*Waveform, error)
+
+	mu        sync.Mutex
+	waveforms map[TrackID]*Waveform
+	inflight  map[TrackID]*waveformCall
+}
+
+// waveformCall is an in-progress waveform lookup, shared by all callers waiting on it.
+type waveformCall struct {
+	done     chan struct{}
+	waveform *Waveform
+	err      error
+}
+
+// NewWaveformCache creates a WaveformCache that gets waveforms using the
+// given Session.
+//
+// If compute is not nil, it is called to make a waveform for any track
+// MyRadio has none for; typically it downloads and decodes the track, then
+// calls ComputeWaveform.
+func NewWaveformCache(s *Session, compute func(trackid TrackID) (*Waveform, error)) *WaveformCache {
+	return &WaveformCache{
+		session:   s,
+		compute:   compute,
+		waveforms: make(map[TrackID]*Waveform),
+		inflight:  make(map[TrackID]*waveformCall),
+	}
+}
+
+// Get gets the waveform of the track with the given ID.
+//
+// The waveform is only computed if MyRadio has none for the track; any
+// other error, such as a timeout, is returned as it is.
+// Failed lookups are not cached.
+//
+// This consumes one API request, unless the waveform is already cached or
+// already being looked up.
+func (c *WaveformCache) Get(trackid TrackID) (*Waveform, error) {
+	c.mu.Lock()
+	if waveform, ok := c.waveforms[trackid]; ok {
+		c.mu.Unlock()
+		return waveform, nil
+	}
+	if call, ok := c.inflight[trackid]; ok {
+		c.mu.Unlock()
+		<-call.done
+		return call.waveform, call.err
+	}
+	call := &waveformCall{done: make(chan struct{})}
+	c.inflight[trackid] = call
+	c.mu.Unlock()
+
+	call.waveform, call.err = c.session.GetTrackWaveform(trackid)
+	if c.compute != nil && (IsNotFound(call.err) || errors.Is(call.err, ErrNoWaveform)) {
+		call.waveform, call.err = c.compute(trackid)
+	}
+
+	c.mu.Lock()
+	if call.err == nil {
+		c.waveforms[trackid] = call.waveform
+	}
+	delete(c.inflight, trackid)
+	c.mu.Unlock()
+	close(call.done)
+
+	return call.waveform, call.err
+}
@@ -0,0 +1,94 @@
+package myradio
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWaveformCache(t *testing.T) {
+	requests := 0
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/track/1/waveform" {
+			writePayload(w, Waveform{PeaksPerSecond: 1, Peaks: []float64{0.5}})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	computed := 0
+	c := NewWaveformCache(s, func(trackid TrackID) (*Waveform, error) {
+		computed++
+		return &Waveform{PeaksPerSecond: 1, Peaks: []float64{0.25}}, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		for trackid, expected := range map[TrackID]float64{1: 0.5, 2: 0.25} {
+			w, err := c.Get(trackid)
+			if err != nil || w.Peaks[0] != expected {
+				t.Error("Got:", w, ", Error:", err)
+			}
+		}
+	}
+	if requests != 2 || computed != 1 {
+		t.Error("Got:", requests, "requests and", computed, "computations, Expected: 2 and 1")
+	}
+}
+
+func TestWaveformCacheErrors(t *testing.T) {
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	computed := 0
+	c := NewWaveformCache(s, func(trackid TrackID) (*Waveform, error) {
+		computed++
+		return &Waveform{}, nil
+	})
+
+	if _, err := c.Get(1); err == nil {
+		t.Error("Expected an error from a failing server")
+	}
+	if computed != 0 {
+		t.Error("Got:", computed, "computations, Expected: none for a server error")
+	}
+}
+
+func TestWaveformCacheSharesLookups(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+	release := make(chan struct{})
+	s := newTestSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		<-release
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	computed := 0
+	c := NewWaveformCache(s, func(trackid TrackID) (*Waveform, error) {
+		computed++
+		return &Waveform{PeaksPerSecond: 1, Peaks: []float64{0.25}}, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if w, err := c.Get(1); err != nil || w.Peaks[0] != 0.25 {
+				t.Error("Got:", w, ", Error:", err)
+			}
+		}()
+	}
+	// Give the lookups time to pile up on the first.
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if requests != 1 || computed != 1 {
+		t.Error("Got:", requests, "requests and", computed, "computations, Expected: 1 and 1")
+	}
+}